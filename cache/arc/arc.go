@@ -0,0 +1,173 @@
+// Package arc contains an implementation of an adaptive replacement cache (ARC).
+//
+// ARC balances between recency and frequency by keeping two lists of resident entries,
+// T1 for entries seen once recently and T2 for entries seen at least twice,
+// and two ghost lists B1 and B2 remembering the keys recently evicted from T1 and T2.
+// Hits in the ghost lists adapt the target size of T1, which makes the cache resistant to scans.
+//
+// See N. Megiddo, D. Modha, "ARC: A Self-Tuning, Low Overhead Replacement Cache", FAST 2003.
+package arc
+
+import "github.com/denpeshkov/datastructures/list/linked"
+
+// list identifies one of the lists of the cache.
+type list int
+
+const (
+	t1 list = iota
+	t2
+	b1
+	b2
+)
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+	// list containing the entry
+	in list
+}
+
+// Cache represents an ARC cache.
+// It is not safe for concurrent use.
+type Cache[K comparable, V any] struct {
+	// Front of each list is the most recently used entry
+	lists [4]*linked.Linked[entry[K, V]]
+	m     map[K]*linked.Element[entry[K, V]]
+	// target size of T1
+	p        int
+	capacity int
+}
+
+// New returns an initialized cache holding at most capacity entries.
+func New[K comparable, V any](capacity int) *Cache[K, V] {
+	c := &Cache[K, V]{
+		m:        make(map[K]*linked.Element[entry[K, V]]),
+		capacity: max(capacity, 0),
+	}
+	for i := range c.lists {
+		c.lists[i] = linked.New[entry[K, V]]()
+	}
+	return c
+}
+
+// len returns the length of list l.
+func (c *Cache[K, V]) len(l list) int {
+	return c.lists[l].Len()
+}
+
+// resident returns whether element e holds a resident entry.
+func resident[K comparable, V any](e *linked.Element[entry[K, V]]) bool {
+	return e.Value.in == t1 || e.Value.in == t2
+}
+
+// Get returns the value associated with the key and marks it as frequently used.
+// The second parameter is true if the key is found; otherwise, it is false.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	e, ok := c.m[key]
+	if !ok || !resident(e) {
+		return *new(V), false
+	}
+	c.move(e, t2)
+	return e.Value.value, true
+}
+
+// Peek returns the value associated with the key without updating the cache's replacement state.
+// The second parameter is true if the key is found; otherwise, it is false.
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	if e, ok := c.m[key]; ok && resident(e) {
+		return e.Value.value, true
+	}
+	return *new(V), false
+}
+
+// Add adds the value with the key to the cache and returns whether it was stored.
+// If the key is already present, its value is replaced.
+func (c *Cache[K, V]) Add(key K, value V) bool {
+	if c.capacity == 0 {
+		return false
+	}
+
+	if e, ok := c.m[key]; ok {
+		switch e.Value.in {
+		case b1:
+			c.p = min(c.capacity, c.p+max(c.len(b2)/c.len(b1), 1))
+			c.replace(false)
+		case b2:
+			c.p = max(0, c.p-max(c.len(b1)/c.len(b2), 1))
+			c.replace(true)
+		}
+		e.Value.value = value
+		c.move(e, t2)
+		return true
+	}
+
+	switch l1 := c.len(t1) + c.len(b1); {
+	case l1 == c.capacity:
+		if c.len(t1) < c.capacity {
+			c.drop(b1)
+			c.replace(false)
+		} else {
+			c.drop(t1)
+		}
+	case c.len(t1)+c.len(t2)+c.len(b1)+c.len(b2) >= c.capacity:
+		if c.len(t1)+c.len(t2)+c.len(b1)+c.len(b2) == 2*c.capacity {
+			c.drop(b2)
+		}
+		c.replace(false)
+	}
+
+	c.m[key] = c.lists[t1].InsertFront(entry[K, V]{key: key, value: value, in: t1})
+	return true
+}
+
+// Remove removes the key from the cache and returns whether it was present.
+func (c *Cache[K, V]) Remove(key K) bool {
+	e, ok := c.m[key]
+	if !ok {
+		return false
+	}
+	c.lists[e.Value.in].Remove(e)
+	delete(c.m, key)
+	return resident(e)
+}
+
+// Len returns the number of entries in the cache.
+func (c *Cache[K, V]) Len() int {
+	return c.len(t1) + c.len(t2)
+}
+
+// replace evicts a resident entry into the corresponding ghost list if the cache is full.
+// inB2 reports whether the entry being added was found in B2.
+func (c *Cache[K, V]) replace(inB2 bool) {
+	if c.len(t1)+c.len(t2) < c.capacity {
+		return
+	}
+	if n := c.len(t1); n > 0 && (n > c.p || (inB2 && n == c.p) || c.len(t2) == 0) {
+		c.move(c.lists[t1].Back(), b1)
+	} else {
+		c.move(c.lists[t2].Back(), b2)
+	}
+}
+
+// move moves element e to the front of list to.
+// Values of entries moved to ghost lists are discarded.
+func (c *Cache[K, V]) move(e *linked.Element[entry[K, V]], to list) {
+	if e.Value.in == to {
+		c.lists[to].MoveToFront(e)
+		return
+	}
+	c.lists[e.Value.in].Remove(e)
+	en := e.Value
+	en.in = to
+	if to == b1 || to == b2 {
+		en.value = *new(V) // avoid loitering
+	}
+	c.m[en.key] = c.lists[to].InsertFront(en)
+}
+
+// drop removes the least recently used entry of list l from the cache.
+func (c *Cache[K, V]) drop(l list) {
+	e := c.lists[l].Back()
+	c.lists[l].Remove(e)
+	delete(c.m, e.Value.key)
+}
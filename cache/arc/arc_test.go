@@ -0,0 +1,76 @@
+package arc
+
+import (
+	"testing"
+)
+
+func TestGetAdd(t *testing.T) {
+	c := New[int, int](2)
+	c.Add(1, 1)
+	c.Add(2, 2)
+	if v, ok := c.Get(1); !ok || v != 1 {
+		t.Errorf("c.Get(1) = (%d, %v), want (1, true)", v, ok)
+	}
+	c.Add(3, 3) // evicts 2 from T1 into B1
+	checkLens(t, c, 1, 1, 1, 0)
+	if _, ok := c.Get(2); ok {
+		t.Errorf("c.Get(2) of an evicted key = true, want false")
+	}
+
+	c.Add(2, 20) // ghost hit in B1 increases the target size of T1
+	if c.p != 1 {
+		t.Errorf("c.p = %d, want 1", c.p)
+	}
+	if v, _ := c.Peek(2); v != 20 {
+		t.Errorf("c.Peek(2) = %d, want 20", v)
+	}
+	if c.Len() != 2 {
+		t.Errorf("c.Len() = %d, want 2", c.Len())
+	}
+}
+
+// Test that a scan of keys seen once does not evict the frequently used keys.
+func TestScanResistance(t *testing.T) {
+	c := New[int, int](10)
+	for k := 0; k < 5; k++ {
+		c.Add(k, k)
+		c.Get(k)
+	}
+	for k := 100; k < 200; k++ {
+		c.Add(k, k)
+	}
+	for k := 0; k < 5; k++ {
+		if _, ok := c.Get(k); !ok {
+			t.Errorf("c.Get(%d) of a frequently used key = false after a scan, want true", k)
+		}
+	}
+	if c.Len() != 10 {
+		t.Errorf("c.Len() = %d, want 10", c.Len())
+	}
+}
+
+func TestRemove(t *testing.T) {
+	c := New[int, int](1)
+	c.Add(1, 1)
+	c.Add(2, 2) // evicts 1 into B1
+
+	if c.Remove(1) {
+		t.Errorf("c.Remove(1) of a ghost entry = true, want false")
+	}
+	if !c.Remove(2) {
+		t.Errorf("c.Remove(2) = false, want true")
+	}
+	checkLens(t, c, 0, 0, 0, 0)
+}
+
+func checkLens(t *testing.T, c *Cache[int, int], lt1, lt2, lb1, lb2 int) {
+	t.Helper()
+
+	got := [4]int{c.len(t1), c.len(t2), c.len(b1), c.len(b2)}
+	if want := [4]int{lt1, lt2, lb1, lb2}; got != want {
+		t.Errorf("lengths of T1, T2, B1, B2 = %v, want %v", got, want)
+	}
+	if len(c.m) != got[0]+got[1]+got[2]+got[3] {
+		t.Errorf("len(c.m) = %d, want %d", len(c.m), got[0]+got[1]+got[2]+got[3])
+	}
+}
@@ -0,0 +1,18 @@
+// Package cache contains implementations of caches.
+package cache
+
+// Cache represents a cache with a bounded number of entries.
+type Cache[K comparable, V any] interface {
+	// Get returns the value associated with the key, updating the cache's replacement state.
+	// The second parameter is true if the key is found; otherwise, it is false.
+	Get(key K) (V, bool)
+	// Peek returns the value associated with the key without updating the cache's replacement state.
+	// The second parameter is true if the key is found; otherwise, it is false.
+	Peek(key K) (V, bool)
+	// Add adds the value with the key to the cache, evicting entries if necessary, and returns whether it was stored.
+	Add(key K, value V) bool
+	// Remove removes the key from the cache and returns whether it was present.
+	Remove(key K) bool
+	// Len returns the number of entries in the cache.
+	Len() int
+}
@@ -0,0 +1,97 @@
+package cache_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/denpeshkov/datastructures/cache"
+	"github.com/denpeshkov/datastructures/cache/arc"
+	"github.com/denpeshkov/datastructures/cache/lfu"
+	"github.com/denpeshkov/datastructures/cache/lru"
+	"github.com/denpeshkov/datastructures/cache/ttl"
+	"github.com/denpeshkov/datastructures/cache/twoq"
+)
+
+var (
+	_ cache.Cache[int, int] = (*lru.Cache[int, int])(nil)
+	_ cache.Cache[int, int] = (*lru.Synced[int, int])(nil)
+	_ cache.Cache[int, int] = (*lfu.Cache[int, int])(nil)
+	_ cache.Cache[int, int] = (*arc.Cache[int, int])(nil)
+	_ cache.Cache[int, int] = (*twoq.Cache[int, int])(nil)
+	_ cache.Cache[int, int] = (*ttl.Cache[int, int])(nil)
+)
+
+const (
+	capacity = 1000
+	keys     = 100_000
+)
+
+var caches = []struct {
+	name string
+	new  func() cache.Cache[uint64, uint64]
+}{
+	{"LRU", func() cache.Cache[uint64, uint64] { return lru.New[uint64, uint64](capacity, nil) }},
+	{"LFU", func() cache.Cache[uint64, uint64] { return lfu.New[uint64, uint64](capacity) }},
+	{"ARC", func() cache.Cache[uint64, uint64] { return arc.New[uint64, uint64](capacity) }},
+	{"2Q", func() cache.Cache[uint64, uint64] { return twoq.New[uint64, uint64](capacity) }},
+}
+
+// zipfTrace returns a trace of n keys drawn from a Zipf distribution with parameter s.
+func zipfTrace(n int, s float64) []uint64 {
+	z := rand.NewZipf(rand.New(rand.NewSource(1)), s, 1, keys-1)
+	trace := make([]uint64, n)
+	for i := range trace {
+		trace[i] = z.Uint64()
+	}
+	return trace
+}
+
+// scanTrace returns a Zipf trace interleaved with sequential scans over keys that are never reused.
+func scanTrace(n int, s float64) []uint64 {
+	trace := zipfTrace(n, s)
+	next := uint64(keys)
+	for i := 0; i+capacity <= len(trace); i += 10 * capacity {
+		for j := i; j < i+capacity; j++ {
+			trace[j] = next
+			next++
+		}
+	}
+	return trace
+}
+
+// run replays the trace against the cache, adding keys on misses, and returns the hit ratio.
+func run(c cache.Cache[uint64, uint64], trace []uint64) float64 {
+	hits := 0
+	for _, k := range trace {
+		if _, ok := c.Get(k); ok {
+			hits++
+		} else {
+			c.Add(k, k)
+		}
+	}
+	return float64(hits) / float64(len(trace))
+}
+
+func BenchmarkHitRatio(b *testing.B) {
+	traces := []struct {
+		name  string
+		trace []uint64
+	}{
+		{"Zipf1.0", zipfTrace(200_000, 1.0001)},
+		{"Zipf1.2", zipfTrace(200_000, 1.2)},
+		{"Zipf1.2Scan", scanTrace(200_000, 1.2)},
+	}
+
+	for _, tr := range traces {
+		for _, c := range caches {
+			b.Run(fmt.Sprintf("%s/%s", tr.name, c.name), func(b *testing.B) {
+				ratio := 0.0
+				for i := 0; i < b.N; i++ {
+					ratio = run(c.new(), tr.trace)
+				}
+				b.ReportMetric(ratio, "hit-ratio")
+			})
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Package lfu contains an implementation of a least frequently used (LFU) cache with O(1) operations.
+//
+// Entries with the same access frequency are kept in a bucket, and buckets are kept in a linked list ordered by frequency.
+// Ties between the least frequently used entries are broken by evicting the least recently used one.
+package lfu
+
+import "github.com/denpeshkov/datastructures/list/linked"
+
+// bucket holds entries with the same access frequency.
+type bucket[K comparable, V any] struct {
+	freq int
+	// Front is the most recently used entry
+	entries *linked.Linked[*entry[K, V]]
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+	// bucket containing the entry
+	b *linked.Element[*bucket[K, V]]
+	// element of the entry in the bucket
+	e *linked.Element[*entry[K, V]]
+}
+
+// Cache represents an LFU cache.
+// It is not safe for concurrent use.
+type Cache[K comparable, V any] struct {
+	// Front is the bucket with the lowest frequency
+	buckets  *linked.Linked[*bucket[K, V]]
+	m        map[K]*entry[K, V]
+	capacity int
+}
+
+// New returns an initialized cache holding at most capacity entries.
+func New[K comparable, V any](capacity int) *Cache[K, V] {
+	return &Cache[K, V]{
+		buckets:  linked.New[*bucket[K, V]](),
+		m:        make(map[K]*entry[K, V]),
+		capacity: max(capacity, 0),
+	}
+}
+
+// Get returns the value associated with the key and increments its access frequency.
+// The second parameter is true if the key is found; otherwise, it is false.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	en, ok := c.m[key]
+	if !ok {
+		return *new(V), false
+	}
+	c.increment(en)
+	return en.value, true
+}
+
+// Peek returns the value associated with the key without updating its access frequency.
+// The second parameter is true if the key is found; otherwise, it is false.
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	if en, ok := c.m[key]; ok {
+		return en.value, true
+	}
+	return *new(V), false
+}
+
+// Add adds the value with the key to the cache and returns whether it was stored.
+// If the key is already present, its value is replaced and its access frequency is incremented.
+// Otherwise, if the cache is full, the least frequently used entry is evicted.
+func (c *Cache[K, V]) Add(key K, value V) bool {
+	if c.capacity == 0 {
+		return false
+	}
+
+	if en, ok := c.m[key]; ok {
+		en.value = value
+		c.increment(en)
+		return true
+	}
+
+	if len(c.m) >= c.capacity {
+		c.remove(c.buckets.Front().Value.entries.Back().Value)
+	}
+
+	b := c.buckets.Front()
+	if b == nil || b.Value.freq != 1 {
+		b = c.buckets.InsertFront(&bucket[K, V]{freq: 1, entries: linked.New[*entry[K, V]]()})
+	}
+	en := &entry[K, V]{key: key, value: value, b: b}
+	en.e = b.Value.entries.InsertFront(en)
+	c.m[key] = en
+	return true
+}
+
+// Remove removes the key from the cache and returns whether it was present.
+func (c *Cache[K, V]) Remove(key K) bool {
+	en, ok := c.m[key]
+	if !ok {
+		return false
+	}
+	c.remove(en)
+	return true
+}
+
+// Len returns the number of entries in the cache.
+func (c *Cache[K, V]) Len() int {
+	return len(c.m)
+}
+
+// Frequency returns the access frequency of the key, or 0 if it is not present.
+func (c *Cache[K, V]) Frequency(key K) int {
+	if en, ok := c.m[key]; ok {
+		return en.b.Value.freq
+	}
+	return 0
+}
+
+// increment moves entry en to the bucket with the next frequency.
+func (c *Cache[K, V]) increment(en *entry[K, V]) {
+	cur := en.b
+	next := cur.Next()
+	if next == nil || next.Value.freq != cur.Value.freq+1 {
+		next = c.buckets.InsertAfter(&bucket[K, V]{freq: cur.Value.freq + 1, entries: linked.New[*entry[K, V]]()}, cur)
+	}
+
+	cur.Value.entries.Remove(en.e)
+	en.e = next.Value.entries.InsertFront(en)
+	en.b = next
+
+	if cur.Value.entries.Empty() {
+		c.buckets.Remove(cur)
+	}
+}
+
+// remove removes entry en from the cache.
+func (c *Cache[K, V]) remove(en *entry[K, V]) {
+	b := en.b
+	b.Value.entries.Remove(en.e)
+	if b.Value.entries.Empty() {
+		c.buckets.Remove(b)
+	}
+	delete(c.m, en.key)
+}
@@ -0,0 +1,74 @@
+package lfu
+
+import (
+	"testing"
+)
+
+func TestEvictLeastFrequent(t *testing.T) {
+	c := New[string, int](3)
+	c.Add("a", 1)
+	c.Add("b", 2)
+	c.Add("c", 3)
+
+	c.Get("a")
+	c.Get("a")
+	c.Get("c")
+	checkFrequencies(t, c, map[string]int{"a": 3, "b": 1, "c": 2})
+
+	c.Add("d", 4) // evicts b, the least frequently used
+	checkFrequencies(t, c, map[string]int{"a": 3, "c": 2, "d": 1})
+
+	c.Add("e", 5) // evicts d, the least frequently used
+	checkFrequencies(t, c, map[string]int{"a": 3, "c": 2, "e": 1})
+}
+
+func TestEvictLeastRecentOnTie(t *testing.T) {
+	c := New[string, int](2)
+	c.Add("a", 1)
+	c.Add("b", 2)
+	c.Add("c", 3) // evicts a, the least recently used of a and b
+	checkFrequencies(t, c, map[string]int{"b": 1, "c": 1})
+}
+
+func TestAddPeekRemove(t *testing.T) {
+	c := New[string, int](2)
+	c.Add("a", 1)
+	c.Add("a", 2) // replaces the value and increments the frequency
+	if v, ok := c.Peek("a"); !ok || v != 2 {
+		t.Errorf("c.Peek(a) = (%d, %v), want (2, true)", v, ok)
+	}
+	checkFrequencies(t, c, map[string]int{"a": 2})
+
+	if !c.Remove("a") || c.Remove("a") {
+		t.Errorf("c.Remove(a) should succeed once")
+	}
+	checkFrequencies(t, c, map[string]int{})
+	if n := c.buckets.Len(); n != 0 {
+		t.Errorf("c.buckets.Len() = %d, want 0", n)
+	}
+
+	if New[string, int](0).Add("a", 1) {
+		t.Errorf("c.Add() with zero capacity = true, want false")
+	}
+}
+
+func checkFrequencies(t *testing.T, c *Cache[string, int], freqs map[string]int) {
+	t.Helper()
+
+	if n := c.Len(); n != len(freqs) {
+		t.Errorf("c.Len() = %d, want %d", n, len(freqs))
+	}
+	for k, f := range freqs {
+		if got := c.Frequency(k); got != f {
+			t.Errorf("c.Frequency(%s) = %d, want %d", k, got, f)
+		}
+	}
+	// buckets are ordered by frequency and not empty
+	prev := 0
+	for b := c.buckets.Front(); b != nil; b = b.Next() {
+		if b.Value.freq <= prev || b.Value.entries.Empty() {
+			t.Errorf("bucket with frequency %d and %d entries after bucket with frequency %d", b.Value.freq, b.Value.entries.Len(), prev)
+		}
+		prev = b.Value.freq
+	}
+}
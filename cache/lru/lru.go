@@ -0,0 +1,254 @@
+// Package lru contains an implementation of a least recently used (LRU) cache backed by a linked list.
+package lru
+
+import (
+	"sync"
+
+	"github.com/denpeshkov/datastructures/list/linked"
+)
+
+// Stats represents cache hit and miss statistics.
+type Stats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// HitRatio returns the fraction of lookups that were hits, or 0 if there were no lookups.
+func (s Stats) HitRatio() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+	cost  int
+}
+
+// Cache represents an LRU cache with a capacity expressed as the total cost of its entries.
+// When the capacity is exceeded, the least recently used entries are evicted.
+// It is not safe for concurrent use, see [Synced].
+type Cache[K comparable, V any] struct {
+	// Front is the most recently used entry
+	l        *linked.Linked[entry[K, V]]
+	m        map[K]*linked.Element[entry[K, V]]
+	capacity int
+	cost     int
+	onEvict  func(key K, value V)
+	stats    Stats
+}
+
+// New returns an initialized cache with the total cost of entries limited by capacity.
+// If onEvict is not nil, it is called for each entry evicted due to the capacity limit.
+func New[K comparable, V any](capacity int, onEvict func(key K, value V)) *Cache[K, V] {
+	return &Cache[K, V]{
+		l:        linked.New[entry[K, V]](),
+		m:        make(map[K]*linked.Element[entry[K, V]]),
+		capacity: max(capacity, 0),
+		onEvict:  onEvict,
+	}
+}
+
+// Get returns the value associated with the key and marks the entry as the most recently used.
+// The second parameter is true if the key is found; otherwise, it is false.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	e, ok := c.m[key]
+	if !ok {
+		c.stats.Misses++
+		return *new(V), false
+	}
+	c.stats.Hits++
+	c.l.MoveToFront(e)
+	return e.Value.value, true
+}
+
+// Peek returns the value associated with the key without updating its recency or the statistics.
+// The second parameter is true if the key is found; otherwise, it is false.
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	if e, ok := c.m[key]; ok {
+		return e.Value.value, true
+	}
+	return *new(V), false
+}
+
+// Contains returns whether the key is present without updating its recency or the statistics.
+func (c *Cache[K, V]) Contains(key K) bool {
+	_, ok := c.m[key]
+	return ok
+}
+
+// Add adds the value with the key to the cache with a cost of 1 and returns whether it was stored.
+// It is equivalent to AddCost(key, value, 1).
+func (c *Cache[K, V]) Add(key K, value V) bool {
+	return c.AddCost(key, value, 1)
+}
+
+// AddCost adds the value with the key and cost to the cache, marks it as the most recently used and returns whether it was stored.
+// If the key is already present, its value and cost are replaced.
+// Least recently used entries are evicted until the total cost fits the capacity.
+// An entry with a cost exceeding the capacity is not stored and removes the key from the cache.
+// The cost must be positive, since zero or negative costs would let the cache grow past its capacity;
+// otherwise, the cache is left unchanged and false is returned.
+func (c *Cache[K, V]) AddCost(key K, value V, cost int) bool {
+	if cost <= 0 {
+		return false
+	}
+	if cost > c.capacity {
+		c.Remove(key)
+		return false
+	}
+
+	if e, ok := c.m[key]; ok {
+		c.cost += cost - e.Value.cost
+		e.Value.value, e.Value.cost = value, cost
+		c.l.MoveToFront(e)
+	} else {
+		c.m[key] = c.l.InsertFront(entry[K, V]{key, value, cost})
+		c.cost += cost
+	}
+	c.evict()
+	return true
+}
+
+// Remove removes the key from the cache and returns whether it was present.
+// The eviction callback is not called.
+func (c *Cache[K, V]) Remove(key K) bool {
+	e, ok := c.m[key]
+	if !ok {
+		return false
+	}
+	c.remove(e)
+	return true
+}
+
+// Resize changes the capacity of the cache, evicting entries if necessary, and returns the number of evicted entries.
+func (c *Cache[K, V]) Resize(capacity int) int {
+	c.capacity = max(capacity, 0)
+	return c.evict()
+}
+
+// Len returns the number of entries in the cache.
+func (c *Cache[K, V]) Len() int {
+	return c.l.Len()
+}
+
+// Cost returns the total cost of entries in the cache.
+func (c *Cache[K, V]) Cost() int {
+	return c.cost
+}
+
+// Capacity returns the capacity of the cache.
+func (c *Cache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Stats returns the hit and miss statistics of the cache.
+func (c *Cache[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// remove removes element e from the cache.
+func (c *Cache[K, V]) remove(e *linked.Element[entry[K, V]]) {
+	c.l.Remove(e)
+	delete(c.m, e.Value.key)
+	c.cost -= e.Value.cost
+}
+
+// evict evicts the least recently used entries until the total cost fits the capacity and returns the number of evicted entries.
+func (c *Cache[K, V]) evict() int {
+	n := 0
+	for c.cost > c.capacity {
+		e := c.l.Back()
+		c.remove(e)
+		if c.onEvict != nil {
+			c.onEvict(e.Value.key, e.Value.value)
+		}
+		n++
+	}
+	return n
+}
+
+// Synced represents an LRU cache guarded by a mutex.
+// It is safe for concurrent use by multiple goroutines.
+type Synced[K comparable, V any] struct {
+	mu sync.Mutex
+	c  *Cache[K, V]
+}
+
+// NewSynced returns an initialized cache safe for concurrent use.
+// The arguments are the same as for [New]; onEvict is called with the mutex held.
+func NewSynced[K comparable, V any](capacity int, onEvict func(key K, value V)) *Synced[K, V] {
+	return &Synced[K, V]{c: New(capacity, onEvict)}
+}
+
+// Get is like [Cache.Get].
+func (s *Synced[K, V]) Get(key K) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Get(key)
+}
+
+// Peek is like [Cache.Peek].
+func (s *Synced[K, V]) Peek(key K) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Peek(key)
+}
+
+// Contains is like [Cache.Contains].
+func (s *Synced[K, V]) Contains(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Contains(key)
+}
+
+// Add is like [Cache.Add].
+func (s *Synced[K, V]) Add(key K, value V) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Add(key, value)
+}
+
+// AddCost is like [Cache.AddCost].
+func (s *Synced[K, V]) AddCost(key K, value V, cost int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.AddCost(key, value, cost)
+}
+
+// Remove is like [Cache.Remove].
+func (s *Synced[K, V]) Remove(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Remove(key)
+}
+
+// Resize is like [Cache.Resize].
+func (s *Synced[K, V]) Resize(capacity int) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Resize(capacity)
+}
+
+// Len is like [Cache.Len].
+func (s *Synced[K, V]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Len()
+}
+
+// Cost is like [Cache.Cost].
+func (s *Synced[K, V]) Cost() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Cost()
+}
+
+// Stats is like [Cache.Stats].
+func (s *Synced[K, V]) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.c.Stats()
+}
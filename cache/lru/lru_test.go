@@ -0,0 +1,182 @@
+package lru
+
+import (
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestGetAdd(t *testing.T) {
+	c := New[string, int](2, nil)
+
+	c.Add("a", 1)
+	c.Add("b", 2)
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Errorf("c.Get(a) = (%d, %v), want (1, true)", v, ok)
+	}
+
+	c.Add("c", 3) // evicts b, the least recently used
+	checkKeys(t, c, []string{"c", "a"})
+	if _, ok := c.Get("b"); ok {
+		t.Errorf("c.Get(b) of an evicted key = true, want false")
+	}
+
+	c.Add("a", 10) // replaces the value and marks a as the most recently used
+	checkKeys(t, c, []string{"a", "c"})
+	if v, _ := c.Peek("a"); v != 10 {
+		t.Errorf("c.Peek(a) = %d, want 10", v)
+	}
+
+	if s := c.Stats(); s.Hits != 1 || s.Misses != 1 || s.HitRatio() != 0.5 {
+		t.Errorf("c.Stats() = %+v, ratio %v; want 1 hit and 1 miss", s, s.HitRatio())
+	}
+}
+
+func TestPeek(t *testing.T) {
+	c := New[string, int](2, nil)
+	c.Add("a", 1)
+	c.Add("b", 2)
+
+	if v, ok := c.Peek("a"); !ok || v != 1 {
+		t.Errorf("c.Peek(a) = (%d, %v), want (1, true)", v, ok)
+	}
+	// Peek does not update the recency
+	checkKeys(t, c, []string{"b", "a"})
+	if s := c.Stats(); s != (Stats{}) {
+		t.Errorf("c.Stats() = %+v after Peek, want zero", s)
+	}
+}
+
+func TestRemove(t *testing.T) {
+	evicted := 0
+	c := New(2, func(string, int) { evicted++ })
+	c.Add("a", 1)
+	c.Add("b", 2)
+
+	if !c.Remove("a") {
+		t.Errorf("c.Remove(a) = false, want true")
+	}
+	if c.Remove("a") {
+		t.Errorf("c.Remove(a) of an absent key = true, want false")
+	}
+	checkKeys(t, c, []string{"b"})
+	if evicted != 0 {
+		t.Errorf("eviction callback called %d times on Remove, want 0", evicted)
+	}
+}
+
+func TestEvictCallback(t *testing.T) {
+	var evicted []string
+	c := New(3, func(k string, _ int) { evicted = append(evicted, k) })
+	for _, k := range []string{"a", "b", "c", "d", "e"} {
+		c.Add(k, 0)
+	}
+	if want := []string{"a", "b"}; !slices.Equal(evicted, want) {
+		t.Errorf("evicted = %v, want %v", evicted, want)
+	}
+
+	evicted = nil
+	if n := c.Resize(1); n != 2 {
+		t.Errorf("c.Resize(1) = %d, want 2", n)
+	}
+	if want := []string{"c", "d"}; !slices.Equal(evicted, want) {
+		t.Errorf("evicted = %v, want %v", evicted, want)
+	}
+	checkKeys(t, c, []string{"e"})
+}
+
+func TestCost(t *testing.T) {
+	c := New[string, int](10, nil)
+
+	c.AddCost("a", 0, 4)
+	c.AddCost("b", 0, 4)
+	if c.Cost() != 8 {
+		t.Errorf("c.Cost() = %d, want 8", c.Cost())
+	}
+	c.AddCost("c", 0, 5) // evicts a
+	checkKeys(t, c, []string{"c", "b"})
+	if c.Cost() != 9 {
+		t.Errorf("c.Cost() = %d, want 9", c.Cost())
+	}
+
+	c.AddCost("b", 0, 1) // replaces the cost
+	if c.Cost() != 6 {
+		t.Errorf("c.Cost() = %d, want 6", c.Cost())
+	}
+
+	if c.AddCost("d", 0, 11) {
+		t.Errorf("c.AddCost() with a cost exceeding the capacity = true, want false")
+	}
+	checkKeys(t, c, []string{"b", "c"})
+}
+
+func TestNonPositiveCost(t *testing.T) {
+	c := New[string, int](2, nil)
+	c.Add("a", 1)
+
+	for _, cost := range []int{0, -100} {
+		if c.AddCost("x", 0, cost) {
+			t.Errorf("c.AddCost() with cost %d = true, want false", cost)
+		}
+		if c.AddCost("a", 2, cost) {
+			t.Errorf("c.AddCost() of a present key with cost %d = true, want false", cost)
+		}
+	}
+	if v, ok := c.Peek("a"); !ok || v != 1 {
+		t.Errorf("c.Peek(a) = (%d, %v), want 1", v, ok)
+	}
+	for i := 0; i < 50; i++ {
+		c.Add(string(rune('b'+i)), i)
+	}
+	if c.Len() != 2 || c.Cost() != 2 {
+		t.Errorf("c.Len() = %d, c.Cost() = %d; want 2, 2", c.Len(), c.Cost())
+	}
+}
+
+func TestSynced(t *testing.T) {
+	c := NewSynced[int, int](100, nil)
+
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				k := (w*1000 + i) % 200
+				if _, ok := c.Get(k); !ok {
+					c.Add(k, i)
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if n := c.Len(); n != 100 {
+		t.Errorf("c.Len() = %d, want 100", n)
+	}
+	if s := c.Stats(); s.Hits+s.Misses != 8000 {
+		t.Errorf("c.Stats() = %+v, want 8000 lookups", s)
+	}
+}
+
+// checkKeys checks the keys of the cache from the most to the least recently used.
+func checkKeys[V any](t *testing.T, c *Cache[string, V], keys []string) {
+	t.Helper()
+
+	if n := c.Len(); n != len(keys) {
+		t.Errorf("c.Len() = %d, want %d", n, len(keys))
+		return
+	}
+	var got []string
+	for e := c.l.Front(); e != nil; e = e.Next() {
+		got = append(got, e.Value.key)
+	}
+	if !slices.Equal(got, keys) {
+		t.Errorf("keys = %v, want %v", got, keys)
+	}
+	for _, k := range keys {
+		if !c.Contains(k) {
+			t.Errorf("c.Contains(%s) = false, want true", k)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+// Package ttl contains an implementation of a cache with per-entry expiration.
+//
+// Expired entries are removed lazily when accessed and actively by a background janitor
+// that pops them from a min-heap ordered by expiration time.
+// Within the capacity limit, the least recently used entries are evicted first.
+package ttl
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+
+	"github.com/denpeshkov/datastructures/list/linked"
+)
+
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+	// index in the expiry heap, or -1 if the entry never expires
+	index int
+}
+
+// expired returns whether the entry is expired at time now.
+func (en *entry[K, V]) expired(now time.Time) bool {
+	return en.index >= 0 && !now.Before(en.expires)
+}
+
+// expiryHeap is a min-heap of entries ordered by expiration time.
+// It implements the [heap.Interface] interface.
+type expiryHeap[K comparable, V any] []*entry[K, V]
+
+func (h expiryHeap[K, V]) Len() int           { return len(h) }
+func (h expiryHeap[K, V]) Less(i, j int) bool { return h[i].expires.Before(h[j].expires) }
+
+func (h expiryHeap[K, V]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *expiryHeap[K, V]) Push(x any) {
+	en := x.(*entry[K, V])
+	en.index = len(*h)
+	*h = append(*h, en)
+}
+
+func (h *expiryHeap[K, V]) Pop() any {
+	old := *h
+	n := len(old)
+	en := old[n-1]
+	old[n-1] = nil // avoid loitering
+	en.index = -1
+	*h = old[:n-1]
+	return en
+}
+
+// Options represents the configuration of a cache.
+type Options struct {
+	// Capacity is the maximum number of entries; 0 means no limit.
+	Capacity int
+	// TTL is the default time to live of entries; 0 means that entries do not expire.
+	TTL time.Duration
+	// CleanupInterval is the interval between runs of the janitor; 0 disables the janitor.
+	CleanupInterval time.Duration
+	// Now returns the current time; nil means [time.Now].
+	Now func() time.Time
+}
+
+// Cache represents a cache with per-entry expiration.
+// It is safe for concurrent use by multiple goroutines.
+type Cache[K comparable, V any] struct {
+	mu sync.Mutex
+	// Front is the most recently used entry
+	l    *linked.Linked[*entry[K, V]]
+	m    map[K]*linked.Element[*entry[K, V]]
+	h    expiryHeap[K, V]
+	opts Options
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// New returns an initialized cache configured by opts.
+// If opts.CleanupInterval is positive, it starts a janitor goroutine, which is stopped by [Cache.Close].
+func New[K comparable, V any](opts Options) *Cache[K, V] {
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	c := &Cache[K, V]{
+		l:    linked.New[*entry[K, V]](),
+		m:    make(map[K]*linked.Element[*entry[K, V]]),
+		opts: opts,
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	if opts.CleanupInterval > 0 {
+		go c.janitor(opts.CleanupInterval)
+	} else {
+		close(c.done)
+	}
+	return c
+}
+
+// janitor periodically removes expired entries until the cache is closed.
+func (c *Cache[K, V]) janitor(interval time.Duration) {
+	defer close(c.done)
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			c.DeleteExpired()
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+// Close stops the janitor goroutine and waits for it to exit.
+// The cache remains usable, but expired entries are only removed lazily.
+func (c *Cache[K, V]) Close() {
+	c.closeOnce.Do(func() { close(c.stop) })
+	<-c.done
+}
+
+// Get returns the value associated with the key and marks it as the most recently used.
+// An expired entry is removed and reported as absent.
+// The second parameter is true if the key is found; otherwise, it is false.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.lookup(key)
+	if !ok {
+		return *new(V), false
+	}
+	c.l.MoveToFront(e)
+	return e.Value.value, true
+}
+
+// Peek returns the value associated with the key without updating its recency.
+// An expired entry is removed and reported as absent.
+// The second parameter is true if the key is found; otherwise, it is false.
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.lookup(key)
+	if !ok {
+		return *new(V), false
+	}
+	return e.Value.value, true
+}
+
+// TTL returns the remaining time to live of the key.
+// The second parameter is false if the key is not found or does not expire.
+func (c *Cache[K, V]) TTL(key K) (time.Duration, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.lookup(key)
+	if !ok || e.Value.index < 0 {
+		return 0, false
+	}
+	return e.Value.expires.Sub(c.opts.Now()), true
+}
+
+// Add adds the value with the key to the cache using the default time to live and returns whether it was stored.
+// It is equivalent to AddTTL(key, value, opts.TTL).
+func (c *Cache[K, V]) Add(key K, value V) bool {
+	return c.AddTTL(key, value, c.opts.TTL)
+}
+
+// AddTTL adds the value with the key to the cache, marks it as the most recently used and returns whether it was stored.
+// The entry expires after ttl; if ttl is 0, it does not expire.
+// If the key is already present, its value and expiration time are replaced.
+// If the cache is full, expired entries are removed first, then the least recently used one is evicted.
+func (c *Cache[K, V]) AddTTL(key K, value V, ttl time.Duration) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.m[key]; ok {
+		c.remove(e)
+	}
+	if c.opts.Capacity > 0 && c.l.Len() >= c.opts.Capacity {
+		c.deleteExpired()
+		if c.l.Len() >= c.opts.Capacity {
+			c.remove(c.l.Back())
+		}
+	}
+
+	en := &entry[K, V]{key: key, value: value, index: -1}
+	if ttl != 0 {
+		en.expires = c.opts.Now().Add(ttl)
+		heap.Push(&c.h, en)
+	}
+	c.m[key] = c.l.InsertFront(en)
+	return true
+}
+
+// Remove removes the key from the cache and returns whether it was present and not expired.
+func (c *Cache[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.m[key]
+	if !ok {
+		return false
+	}
+	expired := e.Value.expired(c.opts.Now())
+	c.remove(e)
+	return !expired
+}
+
+// Len returns the number of entries in the cache.
+// It may include expired entries that have not been removed yet.
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.l.Len()
+}
+
+// DeleteExpired removes all the expired entries and returns their number.
+// It is called periodically by the janitor.
+func (c *Cache[K, V]) DeleteExpired() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.deleteExpired()
+}
+
+// deleteExpired removes all the expired entries and returns their number.
+func (c *Cache[K, V]) deleteExpired() int {
+	now := c.opts.Now()
+	n := 0
+	for len(c.h) > 0 && c.h[0].expired(now) {
+		c.remove(c.m[c.h[0].key])
+		n++
+	}
+	return n
+}
+
+// lookup returns the element of the key, removing it if it is expired.
+func (c *Cache[K, V]) lookup(key K) (*linked.Element[*entry[K, V]], bool) {
+	e, ok := c.m[key]
+	if !ok {
+		return nil, false
+	}
+	if e.Value.expired(c.opts.Now()) {
+		c.remove(e)
+		return nil, false
+	}
+	return e, true
+}
+
+// remove removes element e from the cache.
+func (c *Cache[K, V]) remove(e *linked.Element[*entry[K, V]]) {
+	en := e.Value
+	if en.index >= 0 {
+		heap.Remove(&c.h, en.index)
+	}
+	c.l.Remove(e)
+	delete(c.m, en.key)
+}
@@ -0,0 +1,145 @@
+package ttl
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// clock is a manually advanced clock.
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newCache(opts Options) (*Cache[string, int], *clock) {
+	clk := &clock{now: time.Unix(0, 0)}
+	opts.Now = clk.Now
+	return New[string, int](opts), clk
+}
+
+func TestLazyExpiry(t *testing.T) {
+	c, clk := newCache(Options{TTL: time.Minute})
+	defer c.Close()
+
+	c.Add("a", 1)
+	c.AddTTL("b", 2, 2*time.Minute)
+	c.AddTTL("c", 3, 0)
+
+	clk.Advance(time.Minute)
+	if _, ok := c.Get("a"); ok {
+		t.Errorf("c.Get(a) of an expired key = true, want false")
+	}
+	if v, ok := c.Get("b"); !ok || v != 2 {
+		t.Errorf("c.Get(b) = (%d, %v), want (2, true)", v, ok)
+	}
+	if d, ok := c.TTL("b"); !ok || d != time.Minute {
+		t.Errorf("c.TTL(b) = (%v, %v), want (1m, true)", d, ok)
+	}
+	if c.Len() != 2 {
+		t.Errorf("c.Len() = %d, want 2", c.Len())
+	}
+
+	clk.Advance(time.Hour)
+	if _, ok := c.Peek("b"); ok {
+		t.Errorf("c.Peek(b) of an expired key = true, want false")
+	}
+	if v, ok := c.Get("c"); !ok || v != 3 {
+		t.Errorf("c.Get(c) of a non-expiring key = (%d, %v), want (3, true)", v, ok)
+	}
+	if _, ok := c.TTL("c"); ok {
+		t.Errorf("c.TTL(c) of a non-expiring key = true, want false")
+	}
+}
+
+func TestDeleteExpired(t *testing.T) {
+	c, clk := newCache(Options{TTL: time.Minute})
+	defer c.Close()
+
+	for i, k := range []string{"a", "b", "c", "d"} {
+		c.AddTTL(k, i, time.Duration(i+1)*time.Minute)
+	}
+	c.Add("b", 10) // replaces the expiration time of b
+
+	clk.Advance(2 * time.Minute)
+	if n := c.DeleteExpired(); n != 2 {
+		t.Errorf("c.DeleteExpired() = %d, want 2", n)
+	}
+	if c.Len() != 2 {
+		t.Errorf("c.Len() = %d, want 2", c.Len())
+	}
+	for _, k := range []string{"c", "d"} {
+		if _, ok := c.Peek(k); !ok {
+			t.Errorf("c.Peek(%s) = false, want true", k)
+		}
+	}
+}
+
+func TestCapacity(t *testing.T) {
+	c, clk := newCache(Options{Capacity: 2})
+	defer c.Close()
+
+	c.AddTTL("a", 1, time.Minute)
+	c.Add("b", 2)
+	c.Get("a")
+	c.Add("c", 3) // evicts b, the least recently used
+	if _, ok := c.Peek("b"); ok {
+		t.Errorf("c.Peek(b) of an evicted key = true, want false")
+	}
+
+	clk.Advance(time.Minute)
+	c.Add("d", 4) // removes the expired a instead of evicting c
+	for _, k := range []string{"c", "d"} {
+		if _, ok := c.Peek(k); !ok {
+			t.Errorf("c.Peek(%s) = false, want true", k)
+		}
+	}
+}
+
+func TestRemove(t *testing.T) {
+	c, clk := newCache(Options{TTL: time.Minute})
+	defer c.Close()
+
+	c.Add("a", 1)
+	c.Add("b", 2)
+	if !c.Remove("a") {
+		t.Errorf("c.Remove(a) = false, want true")
+	}
+	clk.Advance(time.Minute)
+	if c.Remove("b") {
+		t.Errorf("c.Remove(b) of an expired key = true, want false")
+	}
+	if c.Len() != 0 || len(c.h) != 0 {
+		t.Errorf("c.Len() = %d, len(c.h) = %d; want 0, 0", c.Len(), len(c.h))
+	}
+}
+
+func TestJanitor(t *testing.T) {
+	c, clk := newCache(Options{TTL: time.Minute, CleanupInterval: time.Millisecond})
+
+	c.Add("a", 1)
+	clk.Advance(time.Minute)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for c.Len() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("janitor did not remove the expired entry")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	c.Close()
+	c.Close() // closing twice is allowed
+}
@@ -0,0 +1,153 @@
+// Package twoq contains an implementation of a 2Q cache.
+//
+// Newly added entries are kept in a FIFO queue A1in. Entries evicted from A1in are remembered in a ghost queue A1out,
+// and only entries accessed again while in A1out are promoted to the main LRU queue Am.
+// Entries accessed once, e.g. by a scan, therefore never flush the frequently used ones.
+//
+// See T. Johnson, D. Shasha, "2Q: A Low Overhead High Performance Buffer Management Replacement Algorithm", VLDB 1994.
+package twoq
+
+import "github.com/denpeshkov/datastructures/list/linked"
+
+// queue identifies one of the queues of the cache.
+type queue int
+
+const (
+	a1in queue = iota
+	a1out
+	am
+)
+
+const (
+	// inRatio is the fraction of the capacity used for A1in.
+	inRatio = 0.25
+	// outRatio is the number of ghost entries in A1out relative to the capacity.
+	outRatio = 0.5
+)
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+	// queue containing the entry
+	in queue
+}
+
+// Cache represents a 2Q cache.
+// It is not safe for concurrent use.
+type Cache[K comparable, V any] struct {
+	// Front of each queue is the most recently added or used entry
+	queues   [3]*linked.Linked[entry[K, V]]
+	m        map[K]*linked.Element[entry[K, V]]
+	capacity int
+	// maximum lengths of A1in and A1out
+	kin, kout int
+}
+
+// New returns an initialized cache holding at most capacity entries.
+func New[K comparable, V any](capacity int) *Cache[K, V] {
+	capacity = max(capacity, 0)
+	c := &Cache[K, V]{
+		m:        make(map[K]*linked.Element[entry[K, V]]),
+		capacity: capacity,
+		kin:      max(int(float64(capacity)*inRatio), 1),
+		kout:     max(int(float64(capacity)*outRatio), 1),
+	}
+	for i := range c.queues {
+		c.queues[i] = linked.New[entry[K, V]]()
+	}
+	return c
+}
+
+// len returns the length of queue q.
+func (c *Cache[K, V]) len(q queue) int {
+	return c.queues[q].Len()
+}
+
+// Get returns the value associated with the key.
+// Entries in Am are marked as the most recently used.
+// The second parameter is true if the key is found; otherwise, it is false.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	e, ok := c.m[key]
+	if !ok || e.Value.in == a1out {
+		return *new(V), false
+	}
+	if e.Value.in == am {
+		c.queues[am].MoveToFront(e)
+	}
+	return e.Value.value, true
+}
+
+// Peek returns the value associated with the key without updating the cache's replacement state.
+// The second parameter is true if the key is found; otherwise, it is false.
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	if e, ok := c.m[key]; ok && e.Value.in != a1out {
+		return e.Value.value, true
+	}
+	return *new(V), false
+}
+
+// Add adds the value with the key to the cache and returns whether it was stored.
+// If the key is already present, its value is replaced.
+func (c *Cache[K, V]) Add(key K, value V) bool {
+	if c.capacity == 0 {
+		return false
+	}
+
+	e, ok := c.m[key]
+	switch {
+	case ok && e.Value.in == am:
+		e.Value.value = value
+		c.queues[am].MoveToFront(e)
+	case ok && e.Value.in == a1in:
+		e.Value.value = value
+	case ok && e.Value.in == a1out:
+		c.queues[a1out].Remove(e)
+		delete(c.m, key)
+		c.reclaim()
+		c.m[key] = c.queues[am].InsertFront(entry[K, V]{key: key, value: value, in: am})
+	default:
+		c.reclaim()
+		c.m[key] = c.queues[a1in].InsertFront(entry[K, V]{key: key, value: value, in: a1in})
+	}
+	return true
+}
+
+// Remove removes the key from the cache and returns whether it was present.
+func (c *Cache[K, V]) Remove(key K) bool {
+	e, ok := c.m[key]
+	if !ok {
+		return false
+	}
+	c.queues[e.Value.in].Remove(e)
+	delete(c.m, key)
+	return e.Value.in != a1out
+}
+
+// Len returns the number of entries in the cache.
+func (c *Cache[K, V]) Len() int {
+	return c.len(a1in) + c.len(am)
+}
+
+// reclaim frees space for a new entry if the cache is full.
+func (c *Cache[K, V]) reclaim() {
+	if c.Len() < c.capacity {
+		return
+	}
+
+	if c.len(a1in) > c.kin || c.len(am) == 0 {
+		// page out the oldest entry of A1in, remembering its key in A1out
+		e := c.queues[a1in].Back()
+		c.queues[a1in].Remove(e)
+		c.m[e.Value.key] = c.queues[a1out].InsertFront(entry[K, V]{key: e.Value.key, in: a1out})
+		if c.len(a1out) > c.kout {
+			old := c.queues[a1out].Back()
+			c.queues[a1out].Remove(old)
+			delete(c.m, old.Value.key)
+		}
+		return
+	}
+
+	e := c.queues[am].Back()
+	c.queues[am].Remove(e)
+	delete(c.m, e.Value.key)
+}
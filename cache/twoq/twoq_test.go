@@ -0,0 +1,77 @@
+package twoq
+
+import (
+	"testing"
+)
+
+func TestPromotion(t *testing.T) {
+	c := New[int, int](4) // kin = 1, kout = 2
+	for k := 0; k < 4; k++ {
+		c.Add(k, k)
+	}
+	checkLens(t, c, 4, 0, 0)
+
+	c.Add(4, 4) // pages out 0 into A1out
+	checkLens(t, c, 4, 1, 0)
+	if _, ok := c.Get(0); ok {
+		t.Errorf("c.Get(0) of a paged out key = true, want false")
+	}
+
+	c.Add(0, 10) // promotes 0 into Am
+	checkLens(t, c, 3, 1, 1)
+	if v, ok := c.Get(0); !ok || v != 10 {
+		t.Errorf("c.Get(0) = (%d, %v), want (10, true)", v, ok)
+	}
+}
+
+// Test that a scan of keys seen once does not evict the keys in Am.
+func TestScanResistance(t *testing.T) {
+	c := New[int, int](8)
+	// make keys 0..3 hot by promoting them into Am
+	for k := 0; k < 4; k++ {
+		c.Add(k, k)
+	}
+	for k := 100; k < 108; k++ {
+		c.Add(k, k)
+	}
+	for k := 0; k < 4; k++ {
+		c.Add(k, k)
+	}
+	for k := 200; k < 300; k++ {
+		c.Add(k, k)
+	}
+	for k := 0; k < 4; k++ {
+		if _, ok := c.Get(k); !ok {
+			t.Errorf("c.Get(%d) of a hot key = false after a scan, want true", k)
+		}
+	}
+	if c.Len() != 8 {
+		t.Errorf("c.Len() = %d, want 8", c.Len())
+	}
+}
+
+func TestRemove(t *testing.T) {
+	c := New[int, int](1)
+	c.Add(1, 1)
+	c.Add(2, 2) // pages out 1 into A1out
+
+	if c.Remove(1) {
+		t.Errorf("c.Remove(1) of a ghost entry = true, want false")
+	}
+	if !c.Remove(2) {
+		t.Errorf("c.Remove(2) = false, want true")
+	}
+	checkLens(t, c, 0, 0, 0)
+}
+
+func checkLens(t *testing.T, c *Cache[int, int], lin, lout, lm int) {
+	t.Helper()
+
+	got := [3]int{c.len(a1in), c.len(a1out), c.len(am)}
+	if want := [3]int{lin, lout, lm}; got != want {
+		t.Errorf("lengths of A1in, A1out, Am = %v, want %v", got, want)
+	}
+	if len(c.m) != got[0]+got[1]+got[2] {
+		t.Errorf("len(c.m) = %d, want %d", len(c.m), got[0]+got[1]+got[2])
+	}
+}
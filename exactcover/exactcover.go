@@ -0,0 +1,190 @@
+// Package exactcover contains a solver of exact cover problems using Knuth's Algorithm X with Dancing Links (DLX).
+//
+// An exact cover problem consists of columns and rows, where each row covers a subset of columns.
+// A solution is a set of rows covering every primary column exactly once and every secondary column at most once.
+// The rows are kept in circular doubly-linked lists, so removing a column and restoring it on backtracking take O(1) time per node.
+//
+// See D. Knuth, "Dancing Links", 2000.
+package exactcover
+
+import (
+	"fmt"
+	"slices"
+)
+
+// root is the index of the root node heading the list of primary columns.
+const root = 0
+
+// Problem represents an exact cover problem.
+//
+// Nodes are stored in slices and linked by indices.
+// Node 0 is the root, nodes 1..columns are column headers, and the rest are the nodes of rows.
+type Problem struct {
+	left, right, up, down []int
+	// col[i] is the header of the column of node i
+	col []int
+	// row[i] is the row of node i
+	row []int
+	// size[c] is the number of nodes in column c
+	size    []int
+	columns int
+	rows    int
+}
+
+// New returns a problem with primary columns numbered [0, primary) and secondary columns numbered [primary, primary+secondary).
+// Primary columns must be covered exactly once, secondary columns at most once.
+func New(primary, secondary int) *Problem {
+	primary, secondary = max(primary, 0), max(secondary, 0)
+	n := primary + secondary + 1
+
+	p := &Problem{
+		left:    make([]int, n),
+		right:   make([]int, n),
+		up:      make([]int, n),
+		down:    make([]int, n),
+		col:     make([]int, n),
+		row:     make([]int, n),
+		size:    make([]int, n),
+		columns: primary + secondary,
+	}
+	for i := 0; i < n; i++ {
+		p.up[i], p.down[i], p.col[i], p.row[i] = i, i, i, -1
+		// secondary columns are not linked into the list of primary columns
+		p.left[i], p.right[i] = i, i
+	}
+	for i := 1; i <= primary; i++ {
+		p.left[i] = p.left[root]
+		p.right[i] = root
+		p.right[p.left[root]] = i
+		p.left[root] = i
+	}
+	return p
+}
+
+// AddRow adds a row covering the columns cols and returns the index of the row.
+// Rows are indexed sequentially starting from 0.
+func (p *Problem) AddRow(cols ...int) (int, error) {
+	if len(cols) == 0 {
+		return 0, fmt.Errorf("row covers no columns")
+	}
+	sorted := slices.Clone(cols)
+	slices.Sort(sorted)
+	for i, c := range sorted {
+		if c < 0 || c >= p.columns {
+			return 0, fmt.Errorf("column c=%v out of bounds: [%v, %v]", c, 0, p.columns-1)
+		}
+		if i > 0 && c == sorted[i-1] {
+			return 0, fmt.Errorf("duplicate column c=%v", c)
+		}
+	}
+
+	r := p.rows
+	first := len(p.col)
+	for _, c := range cols {
+		h := c + 1
+		i := len(p.col)
+		p.col = append(p.col, h)
+		p.row = append(p.row, r)
+
+		// insert at the bottom of the column
+		p.up = append(p.up, p.up[h])
+		p.down = append(p.down, h)
+		p.down[p.up[h]] = i
+		p.up[h] = i
+		p.size[h]++
+
+		// insert at the end of the row
+		if i == first {
+			p.left = append(p.left, i)
+			p.right = append(p.right, i)
+		} else {
+			p.left = append(p.left, p.left[first])
+			p.right = append(p.right, first)
+			p.right[p.left[first]] = i
+			p.left[first] = i
+		}
+	}
+	p.rows++
+	return r, nil
+}
+
+// Rows returns the number of rows in the problem.
+func (p *Problem) Rows() int {
+	return p.rows
+}
+
+// cover removes column c from the list of columns and the rows intersecting c from the other columns.
+func (p *Problem) cover(c int) {
+	p.right[p.left[c]] = p.right[c]
+	p.left[p.right[c]] = p.left[c]
+	for i := p.down[c]; i != c; i = p.down[i] {
+		for j := p.right[i]; j != i; j = p.right[j] {
+			p.down[p.up[j]] = p.down[j]
+			p.up[p.down[j]] = p.up[j]
+			p.size[p.col[j]]--
+		}
+	}
+}
+
+// uncover restores column c removed by cover, in exactly the reverse order.
+func (p *Problem) uncover(c int) {
+	for i := p.up[c]; i != c; i = p.up[i] {
+		for j := p.left[i]; j != i; j = p.left[j] {
+			p.size[p.col[j]]++
+			p.down[p.up[j]] = j
+			p.up[p.down[j]] = j
+		}
+	}
+	p.right[p.left[c]] = c
+	p.left[p.right[c]] = c
+}
+
+// Solve calls f for each solution of the problem with the indices of the rows forming the solution.
+// The slice passed to f is only valid during the call.
+// If f returns false, Solve stops the enumeration.
+// The problem is left unchanged, so Solve can be called again.
+func (p *Problem) Solve(f func(rows []int) bool) {
+	p.search(make([]int, 0, p.columns), f)
+}
+
+// search extends the partial solution sol and returns false if the enumeration should stop.
+func (p *Problem) search(sol []int, f func(rows []int) bool) bool {
+	if p.right[root] == root {
+		return f(sol)
+	}
+
+	// choose the column with the fewest rows
+	c := p.right[root]
+	for j := p.right[c]; j != root; j = p.right[j] {
+		if p.size[j] < p.size[c] {
+			c = j
+		}
+	}
+	if p.size[c] == 0 {
+		return true
+	}
+
+	cont := true
+	p.cover(c)
+	for r := p.down[c]; r != c && cont; r = p.down[r] {
+		for j := p.right[r]; j != r; j = p.right[j] {
+			p.cover(p.col[j])
+		}
+		cont = p.search(append(sol, p.row[r]), f)
+		for j := p.left[r]; j != r; j = p.left[j] {
+			p.uncover(p.col[j])
+		}
+	}
+	p.uncover(c)
+	return cont
+}
+
+// Count returns the number of solutions of the problem.
+func (p *Problem) Count() int {
+	n := 0
+	p.Solve(func([]int) bool {
+		n++
+		return true
+	})
+	return n
+}
@@ -0,0 +1,121 @@
+package exactcover
+
+import (
+	"slices"
+	"testing"
+)
+
+// knuth returns the example problem from Knuth's paper.
+func knuth(t *testing.T) *Problem {
+	p := New(7, 0)
+	for _, r := range [][]int{
+		{2, 4, 5},
+		{0, 3, 6},
+		{1, 2, 5},
+		{0, 3},
+		{1, 6},
+		{3, 4, 6},
+	} {
+		mustAddRow(t, p, r...)
+	}
+	return p
+}
+
+// mustAddRow adds a row to the problem, failing the test on an error.
+func mustAddRow(t *testing.T, p *Problem, cols ...int) {
+	t.Helper()
+
+	if _, err := p.AddRow(cols...); err != nil {
+		t.Fatalf("p.AddRow(%v) returned an error: %v", cols, err)
+	}
+}
+
+func TestSolve(t *testing.T) {
+	p := knuth(t)
+
+	var sols [][]int
+	p.Solve(func(rows []int) bool {
+		sols = append(sols, slices.Clone(rows))
+		return true
+	})
+	if len(sols) != 1 {
+		t.Fatalf("found %d solutions, want 1", len(sols))
+	}
+	slices.Sort(sols[0])
+	if want := []int{0, 3, 4}; !slices.Equal(sols[0], want) {
+		t.Errorf("solution = %v, want %v", sols[0], want)
+	}
+
+	// the problem is restored after solving
+	if n := p.Count(); n != 1 {
+		t.Errorf("p.Count() = %d, want 1", n)
+	}
+}
+
+func TestSecondary(t *testing.T) {
+	// columns 0 and 1 are primary, column 2 is secondary
+	p := New(2, 1)
+	mustAddRow(t, p, 0, 2)
+	mustAddRow(t, p, 1, 2)
+	mustAddRow(t, p, 0)
+	mustAddRow(t, p, 1)
+
+	// rows 0 and 1 cannot be combined because they both cover column 2
+	var sols [][]int
+	p.Solve(func(rows []int) bool {
+		s := slices.Clone(rows)
+		slices.Sort(s)
+		sols = append(sols, s)
+		return true
+	})
+	slices.SortFunc(sols, slices.Compare[[]int])
+	want := [][]int{{0, 3}, {1, 2}, {2, 3}}
+	if !slices.EqualFunc(sols, want, slices.Equal[[]int]) {
+		t.Errorf("solutions = %v, want %v", sols, want)
+	}
+}
+
+func TestEarlyStop(t *testing.T) {
+	p := New(1, 0)
+	for i := 0; i < 5; i++ {
+		mustAddRow(t, p, 0)
+	}
+	if n := p.Count(); n != 5 {
+		t.Errorf("p.Count() = %d, want 5", n)
+	}
+
+	calls := 0
+	p.Solve(func([]int) bool {
+		calls++
+		return calls < 2
+	})
+	if calls != 2 {
+		t.Errorf("f called %d times, want 2", calls)
+	}
+	if n := p.Count(); n != 5 {
+		t.Errorf("p.Count() after an early stop = %d, want 5", n)
+	}
+}
+
+func TestNoSolution(t *testing.T) {
+	p := New(2, 0)
+	mustAddRow(t, p, 0)
+	if n := p.Count(); n != 0 {
+		t.Errorf("p.Count() = %d, want 0", n)
+	}
+}
+
+func TestAddRowErrors(t *testing.T) {
+	p := New(2, 1)
+	for _, r := range [][]int{{}, {3}, {-1}, {0, 0}} {
+		if _, err := p.AddRow(r...); err == nil {
+			t.Errorf("p.AddRow(%v) should return an error", r)
+		}
+	}
+	if n := p.Rows(); n != 0 {
+		t.Errorf("p.Rows() = %d, want 0", n)
+	}
+	if r, err := p.AddRow(2, 0); err != nil || r != 0 {
+		t.Errorf("p.AddRow(2, 0) = (%d, %v), want (0, nil)", r, err)
+	}
+}
@@ -0,0 +1,107 @@
+package exactcover_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/denpeshkov/datastructures/exactcover"
+)
+
+// This example solves a sudoku puzzle.
+// Each row places a digit d in cell (r, c) and covers four primary columns:
+// the cell, digit d in row r, digit d in column c and digit d in the box.
+func Example_sudoku() {
+	const puzzle = "" +
+		"53..7...." +
+		"6..195..." +
+		".98....6." +
+		"8...6...3" +
+		"4..8.3..1" +
+		"7...2...6" +
+		".6....28." +
+		"...419..5" +
+		"....8..79"
+
+	p := exactcover.New(4*81, 0)
+	type placement struct{ r, c, d int }
+	var placements []placement
+	for r := 0; r < 9; r++ {
+		for c := 0; c < 9; c++ {
+			for d := 0; d < 9; d++ {
+				if ch := puzzle[9*r+c]; ch != '.' && int(ch-'1') != d {
+					continue
+				}
+				b := 3*(r/3) + c/3
+				if _, err := p.AddRow(9*r+c, 81+9*r+d, 2*81+9*c+d, 3*81+9*b+d); err != nil {
+					panic(err)
+				}
+				placements = append(placements, placement{r, c, d})
+			}
+		}
+	}
+
+	p.Solve(func(rows []int) bool {
+		var grid [9][9]byte
+		for _, i := range rows {
+			pl := placements[i]
+			grid[pl.r][pl.c] = byte('1' + pl.d)
+		}
+		for _, line := range grid {
+			fmt.Println(string(line[:]))
+		}
+		return false
+	})
+	// Output:
+	// 534678912
+	// 672195348
+	// 198342567
+	// 859761423
+	// 426853791
+	// 713924856
+	// 961537284
+	// 287419635
+	// 345286179
+}
+
+// This example solves the N-queens problem.
+// Ranks and files are primary columns that must be covered exactly once,
+// diagonals are secondary columns that can be covered at most once.
+func Example_nQueens() {
+	const n = 8
+
+	p := exactcover.New(2*n, 2*(2*n-1))
+	for r := 0; r < n; r++ {
+		for c := 0; c < n; c++ {
+			if _, err := p.AddRow(r, n+c, 2*n+r+c, 2*n+(2*n-1)+(r-c+n-1)); err != nil {
+				panic(err)
+			}
+		}
+	}
+	fmt.Println("solutions:", p.Count())
+
+	p.Solve(func(rows []int) bool {
+		var board [n][n]string
+		for r := range board {
+			for c := range board[r] {
+				board[r][c] = "."
+			}
+		}
+		for _, i := range rows {
+			board[i/n][i%n] = "Q"
+		}
+		for _, line := range board {
+			fmt.Println(strings.Join(line[:], ""))
+		}
+		return false
+	})
+	// Output:
+	// solutions: 92
+	// Q.......
+	// ....Q...
+	// .......Q
+	// .....Q..
+	// ..Q.....
+	// ......Q.
+	// .Q......
+	// ...Q....
+}
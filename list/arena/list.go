@@ -0,0 +1,206 @@
+// Package arena contains an implementation of a doubly-linked circular list with nodes stored in a single slice.
+//
+// Unlike [github.com/denpeshkov/datastructures/list/linked], nodes are not separate heap objects:
+// they link to each other via int32 indices, and removed nodes are reused through a free list.
+// This keeps the garbage collector from scanning every node of large lists.
+// Elements are referred to by lightweight [Handle] values instead of pointers.
+package arena
+
+import (
+	"fmt"
+	"math"
+)
+
+// root is the index of the sentinel node.
+const root = 0
+
+// node represents a node of the list.
+type node[T any] struct {
+	value      T
+	prev, next int32
+	// gen is incremented each time the node is freed, invalidating the handles to it
+	gen  uint32
+	used bool
+}
+
+// Handle refers to an element of the list.
+// The zero Handle refers to no element.
+// A handle becomes stale once its element is removed and is then ignored by all operations.
+type Handle struct {
+	i   int32
+	gen uint32
+}
+
+// IsZero returns whether h is the zero Handle.
+func (h Handle) IsZero() bool {
+	return h == Handle{}
+}
+
+// List represents a doubly-linked circular list backed by a slice of nodes.
+type List[T any] struct {
+	// Sentinel node is nodes[root]
+	// Head is nodes[root].next, tail is nodes[root].prev
+	nodes []node[T]
+	// free is the index of the first node in the free list linked by next, or root if the list is empty
+	free int32
+	len  int
+}
+
+// New returns an initialized list with space for at least size elements.
+func New[T any](size int) *List[T] {
+	l := &List[T]{nodes: make([]node[T], 1, max(size, 0)+1)}
+	l.nodes[root].used = true
+	return l
+}
+
+// handle returns the handle of the node at index i, or the zero handle if i is the sentinel.
+func (l *List[T]) handle(i int32) Handle {
+	if i == root {
+		return Handle{}
+	}
+	return Handle{i, l.nodes[i].gen}
+}
+
+// valid returns whether h refers to an element of the list.
+func (l *List[T]) valid(h Handle) bool {
+	return h.i > root && int(h.i) < len(l.nodes) && l.nodes[h.i].used && l.nodes[h.i].gen == h.gen
+}
+
+// Contains returns whether h refers to an element of the list.
+func (l *List[T]) Contains(h Handle) bool {
+	return l.valid(h)
+}
+
+// Front returns the first element of the list or the zero Handle if the list is empty.
+func (l *List[T]) Front() Handle {
+	return l.handle(l.nodes[root].next)
+}
+
+// Back returns the last element of the list or the zero Handle if the list is empty.
+func (l *List[T]) Back() Handle {
+	return l.handle(l.nodes[root].prev)
+}
+
+// Next returns the element following h or the zero Handle.
+func (l *List[T]) Next(h Handle) Handle {
+	if !l.valid(h) {
+		return Handle{}
+	}
+	return l.handle(l.nodes[h.i].next)
+}
+
+// Prev returns the element preceding h or the zero Handle.
+func (l *List[T]) Prev(h Handle) Handle {
+	if !l.valid(h) {
+		return Handle{}
+	}
+	return l.handle(l.nodes[h.i].prev)
+}
+
+// Value returns the value of element h.
+// The second parameter is false if h does not refer to an element of the list.
+func (l *List[T]) Value(h Handle) (T, bool) {
+	if !l.valid(h) {
+		return *new(T), false
+	}
+	return l.nodes[h.i].value, true
+}
+
+// SetValue sets the value of element h to v.
+// It returns false if h does not refer to an element of the list.
+func (l *List[T]) SetValue(h Handle, v T) bool {
+	if !l.valid(h) {
+		return false
+	}
+	l.nodes[h.i].value = v
+	return true
+}
+
+// alloc returns the index of an unused node holding value v, reusing a free node if possible.
+func (l *List[T]) alloc(v T) int32 {
+	if i := l.free; i != root {
+		n := &l.nodes[i]
+		l.free = n.next
+		n.value, n.used = v, true
+		return i
+	}
+	if len(l.nodes) > math.MaxInt32 {
+		panic(fmt.Sprintf("list length exceeds %v elements", math.MaxInt32))
+	}
+	l.nodes = append(l.nodes, node[T]{value: v, gen: 1, used: true})
+	return int32(len(l.nodes) - 1)
+}
+
+// insertAfter inserts a new node with value v after the node at index p and returns its handle.
+func (l *List[T]) insertAfter(v T, p int32) Handle {
+	i := l.alloc(v)
+	next := l.nodes[p].next
+
+	l.nodes[i].prev = p
+	l.nodes[i].next = next
+	l.nodes[p].next = i
+	l.nodes[next].prev = i
+
+	l.len++
+
+	return l.handle(i)
+}
+
+// InsertFront inserts a new element with value v at the front of the list and returns its handle.
+func (l *List[T]) InsertFront(v T) Handle {
+	return l.insertAfter(v, root)
+}
+
+// InsertBack inserts a new element with value v at the back of the list and returns its handle.
+func (l *List[T]) InsertBack(v T) Handle {
+	return l.insertAfter(v, l.nodes[root].prev)
+}
+
+// InsertBefore inserts a new element with value v immediately before mark and returns its handle.
+// If mark is not an element of the list l, the zero Handle is returned.
+func (l *List[T]) InsertBefore(v T, mark Handle) Handle {
+	if !l.valid(mark) {
+		return Handle{}
+	}
+	return l.insertAfter(v, l.nodes[mark.i].prev)
+}
+
+// InsertAfter inserts a new element with value v immediately after mark and returns its handle.
+// If mark is not an element of the list l, the zero Handle is returned.
+func (l *List[T]) InsertAfter(v T, mark Handle) Handle {
+	if !l.valid(mark) {
+		return Handle{}
+	}
+	return l.insertAfter(v, mark.i)
+}
+
+// Remove removes element h from list l if h is an element of list l.
+// The node of the element is reused by subsequent insertions.
+func (l *List[T]) Remove(h Handle) {
+	if !l.valid(h) {
+		return
+	}
+
+	n := &l.nodes[h.i]
+	l.nodes[n.prev].next = n.next
+	l.nodes[n.next].prev = n.prev
+
+	n.value = *new(T) // avoid loitering
+	n.used = false
+	n.gen++
+	n.prev = root
+	n.next = l.free
+	l.free = h.i
+
+	l.len--
+}
+
+// Len returns the number of elements in the list.
+func (l *List[T]) Len() int {
+	return l.len
+}
+
+// Empty returns whether the list is empty.
+func (l *List[T]) Empty() bool {
+	return l.len == 0
+}
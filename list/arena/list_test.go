@@ -0,0 +1,150 @@
+package arena
+
+import (
+	"testing"
+)
+
+func TestList(t *testing.T) {
+	l := New[int](0)
+	checkList(t, l, []Handle{})
+
+	// Single element list
+	h := l.InsertFront(1)
+	checkList(t, l, []Handle{h})
+	l.Remove(h)
+	checkList(t, l, []Handle{})
+
+	// Bigger list
+	h2 := l.InsertFront(2)
+	h1 := l.InsertFront(1)
+	h3 := l.InsertBack(3)
+	h4 := l.InsertBack(4)
+	checkList(t, l, []Handle{h1, h2, h3, h4})
+
+	l.Remove(h2)
+	checkList(t, l, []Handle{h1, h3, h4})
+
+	h2 = l.InsertBefore(2, h1) // insert before front
+	checkList(t, l, []Handle{h2, h1, h3, h4})
+	l.Remove(h2)
+	h2 = l.InsertBefore(2, h3) // insert before middle
+	checkList(t, l, []Handle{h1, h2, h3, h4})
+	l.Remove(h2)
+	h2 = l.InsertAfter(2, h4) // insert after back
+	checkList(t, l, []Handle{h1, h3, h4, h2})
+
+	sum := 0
+	for h := l.Front(); !h.IsZero(); h = l.Next(h) {
+		v, _ := l.Value(h)
+		sum += v
+	}
+	if sum != 10 {
+		t.Errorf("sum over l = %d, want 10", sum)
+	}
+
+	// Clear all elements by iterating
+	var next Handle
+	for h := l.Front(); !h.IsZero(); h = next {
+		next = l.Next(h)
+		l.Remove(h)
+	}
+	checkList(t, l, []Handle{})
+}
+
+func TestNodeReuse(t *testing.T) {
+	l := New[int](0)
+	for i := 0; i < 10; i++ {
+		l.InsertBack(i)
+	}
+	for h := l.Front(); !h.IsZero(); h = l.Front() {
+		l.Remove(h)
+	}
+	for i := 0; i < 10; i++ {
+		l.InsertBack(i)
+	}
+	if n := len(l.nodes); n != 11 {
+		t.Errorf("len(l.nodes) = %d, want 11", n)
+	}
+}
+
+func TestStaleHandle(t *testing.T) {
+	l := New[int](0)
+	h1 := l.InsertBack(1)
+	l.Remove(h1)
+	h2 := l.InsertBack(2) // reuses the node of h1
+	if h1.i != h2.i {
+		t.Fatalf("node of a removed element is not reused")
+	}
+
+	if l.Contains(h1) {
+		t.Errorf("l.Contains(stale) = true, want false")
+	}
+	if v, ok := l.Value(h1); ok {
+		t.Errorf("l.Value(stale) = (%d, true), want (0, false)", v)
+	}
+	if l.SetValue(h1, 3) {
+		t.Errorf("l.SetValue(stale) = true, want false")
+	}
+	if h := l.InsertBefore(3, h1); !h.IsZero() {
+		t.Errorf("l.InsertBefore(stale) = %v, want zero Handle", h)
+	}
+	if !l.Next(h1).IsZero() || !l.Prev(h1).IsZero() {
+		t.Errorf("l.Next(stale) or l.Prev(stale) is not the zero Handle")
+	}
+	l.Remove(h1)
+	checkList(t, l, []Handle{h2})
+	if v, _ := l.Value(h2); v != 2 {
+		t.Errorf("l.Value(h2) = %d, want 2", v)
+	}
+}
+
+func TestSetValue(t *testing.T) {
+	l := New[int](0)
+	h := l.InsertBack(1)
+	if !l.SetValue(h, 2) {
+		t.Errorf("l.SetValue(h, 2) = false, want true")
+	}
+	if v, ok := l.Value(h); !ok || v != 2 {
+		t.Errorf("l.Value(h) = (%d, %v), want (2, true)", v, ok)
+	}
+}
+
+func checkList[T any](t *testing.T, l *List[T], hs []Handle) {
+	t.Helper()
+
+	if n := l.Len(); n != len(hs) {
+		t.Errorf("l.Len() = %d, want %d", n, len(hs))
+		return
+	}
+	if l.Empty() != (len(hs) == 0) {
+		t.Errorf("l.Empty() = %v, want %v", l.Empty(), len(hs) == 0)
+	}
+	if len(hs) == 0 {
+		if !l.Front().IsZero() || !l.Back().IsZero() {
+			t.Errorf("l.Front() = %v, l.Back() = %v; both should be zero", l.Front(), l.Back())
+		}
+		return
+	}
+
+	for i, h := range hs {
+		var prev, next Handle
+		if i > 0 {
+			prev = hs[i-1]
+		}
+		if i < len(hs)-1 {
+			next = hs[i+1]
+		}
+		if p := l.Prev(h); p != prev {
+			t.Errorf("l.Prev(elt[%d]) = %v, want %v", i, p, prev)
+		}
+		if n := l.Next(h); n != next {
+			t.Errorf("l.Next(elt[%d]) = %v, want %v", i, n, next)
+		}
+	}
+	if f := l.Front(); f != hs[0] {
+		t.Errorf("l.Front() = %v, want %v", f, hs[0])
+	}
+	if b := l.Back(); b != hs[len(hs)-1] {
+		t.Errorf("l.Back() = %v, want %v", b, hs[len(hs)-1])
+	}
+}
@@ -0,0 +1,150 @@
+// Package concurrent contains an implementation of a concurrent ordered set backed by a linked list.
+//
+// The list uses lazy synchronization: Add and Remove lock only the two nodes around the affected position,
+// removed nodes are first marked and then physically unlinked, and Contains is wait-free and takes no locks.
+package concurrent
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// node represents a node of the list.
+type node[T any] struct {
+	v  T
+	mu sync.Mutex
+	// marked is set when the node is logically removed
+	marked atomic.Bool
+	next   atomic.Pointer[node[T]]
+	// head and tail sentinels compare less and greater than any value, respectively
+	sentinel int
+}
+
+const (
+	headSentinel = -1
+	tailSentinel = 1
+)
+
+// List represents a concurrent set of values ordered by a comparator.
+// It is safe for concurrent use by multiple goroutines.
+type List[T any] struct {
+	cmp  func(a, b T) int
+	head *node[T]
+	len  atomic.Int64
+}
+
+// New returns an initialized list ordered by the cmp function.
+// The cmp function should return a negative number when a < b, a positive number when a > b and zero when a == b.
+func New[T any](cmp func(a, b T) int) *List[T] {
+	tail := &node[T]{sentinel: tailSentinel}
+	head := &node[T]{sentinel: headSentinel}
+	head.next.Store(tail)
+	return &List[T]{cmp: cmp, head: head}
+}
+
+// compare compares the value of node n with v.
+func (l *List[T]) compare(n *node[T], v T) int {
+	if n.sentinel != 0 {
+		return n.sentinel
+	}
+	return l.cmp(n.v, v)
+}
+
+// find returns the last node with value less than v and its successor.
+func (l *List[T]) find(v T) (pred, curr *node[T]) {
+	pred = l.head
+	curr = pred.next.Load()
+	for l.compare(curr, v) < 0 {
+		pred = curr
+		curr = curr.next.Load()
+	}
+	return pred, curr
+}
+
+// validate returns whether pred and curr are unmarked and pred points to curr.
+// Both nodes must be locked.
+func validate[T any](pred, curr *node[T]) bool {
+	return !pred.marked.Load() && !curr.marked.Load() && pred.next.Load() == curr
+}
+
+// Add adds value v to the list.
+// It returns false if the value is already present.
+func (l *List[T]) Add(v T) bool {
+	for {
+		pred, curr := l.find(v)
+
+		pred.mu.Lock()
+		curr.mu.Lock()
+		if !validate(pred, curr) {
+			curr.mu.Unlock()
+			pred.mu.Unlock()
+			continue
+		}
+
+		added := false
+		if l.compare(curr, v) != 0 {
+			n := &node[T]{v: v}
+			n.next.Store(curr)
+			pred.next.Store(n)
+			l.len.Add(1)
+			added = true
+		}
+		curr.mu.Unlock()
+		pred.mu.Unlock()
+		return added
+	}
+}
+
+// Remove removes value v from the list.
+// It returns false if the value is not present.
+func (l *List[T]) Remove(v T) bool {
+	for {
+		pred, curr := l.find(v)
+
+		pred.mu.Lock()
+		curr.mu.Lock()
+		if !validate(pred, curr) {
+			curr.mu.Unlock()
+			pred.mu.Unlock()
+			continue
+		}
+
+		removed := false
+		if l.compare(curr, v) == 0 {
+			curr.marked.Store(true)
+			pred.next.Store(curr.next.Load())
+			l.len.Add(-1)
+			removed = true
+		}
+		curr.mu.Unlock()
+		pred.mu.Unlock()
+		return removed
+	}
+}
+
+// Contains returns whether value v is present in the list.
+// It never blocks.
+func (l *List[T]) Contains(v T) bool {
+	_, curr := l.find(v)
+	return l.compare(curr, v) == 0 && !curr.marked.Load()
+}
+
+// Len returns the number of elements in the list.
+// If the list is modified concurrently, the result is approximate.
+func (l *List[T]) Len() int {
+	return int(l.len.Load())
+}
+
+// Do calls f sequentially for each value present in the list in ascending order.
+// If f returns false, Do stops the iteration.
+// It never blocks; values added or removed concurrently may or may not be visited.
+func (l *List[T]) Do(f func(v T) bool) {
+	for n := l.head.next.Load(); n.sentinel == 0; n = n.next.Load() {
+		if n.marked.Load() {
+			continue
+		}
+		if !f(n.v) {
+			return
+		}
+	}
+}
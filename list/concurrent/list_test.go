@@ -0,0 +1,168 @@
+package concurrent
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestList(t *testing.T) {
+	l := New(cmp.Compare[int])
+	checkList(t, l, []int{})
+
+	for _, v := range []int{3, 1, 2} {
+		if !l.Add(v) {
+			t.Errorf("l.Add(%d) = false, want true", v)
+		}
+	}
+	if l.Add(2) {
+		t.Errorf("l.Add(2) of a present value = true, want false")
+	}
+	checkList(t, l, []int{1, 2, 3})
+
+	if !l.Contains(2) || l.Contains(4) {
+		t.Errorf("l.Contains(2) = %v, l.Contains(4) = %v; want true, false", l.Contains(2), l.Contains(4))
+	}
+
+	if !l.Remove(2) {
+		t.Errorf("l.Remove(2) = false, want true")
+	}
+	if l.Remove(2) {
+		t.Errorf("l.Remove(2) of an absent value = true, want false")
+	}
+	checkList(t, l, []int{1, 3})
+}
+
+// Test that operations of each goroutine on its own keys behave as if executed sequentially,
+// while other goroutines concurrently modify and read the list.
+func TestConcurrentDisjoint(t *testing.T) {
+	const (
+		workers = 8
+		ops     = 2000
+		keys    = 64
+	)
+	l := New(cmp.Compare[int])
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			rnd := rand.New(rand.NewSource(int64(w)))
+			present := make(map[int]bool)
+			for i := 0; i < ops; i++ {
+				// keys of worker w are congruent to w modulo workers
+				k := rnd.Intn(keys)*workers + w
+				switch rnd.Intn(3) {
+				case 0:
+					if got := l.Add(k); got != !present[k] {
+						t.Errorf("l.Add(%d) = %v, want %v", k, got, !present[k])
+					}
+					present[k] = true
+				case 1:
+					if got := l.Remove(k); got != present[k] {
+						t.Errorf("l.Remove(%d) = %v, want %v", k, got, present[k])
+					}
+					present[k] = false
+				default:
+					if got := l.Contains(k); got != present[k] {
+						t.Errorf("l.Contains(%d) = %v, want %v", k, got, present[k])
+					}
+				}
+			}
+		}(w)
+	}
+
+	// concurrent readers must always observe an ordered list
+	stop := make(chan struct{})
+	var rwg sync.WaitGroup
+	rwg.Add(1)
+	go func() {
+		defer rwg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			var vs []int
+			l.Do(func(v int) bool {
+				vs = append(vs, v)
+				return true
+			})
+			if !slices.IsSorted(vs) {
+				t.Errorf("l.Do() visited unordered values: %v", vs)
+				return
+			}
+		}
+	}()
+
+	wg.Wait()
+	close(stop)
+	rwg.Wait()
+
+	n := 0
+	l.Do(func(int) bool {
+		n++
+		return true
+	})
+	if n != l.Len() {
+		t.Errorf("l.Len() = %d, want %d", l.Len(), n)
+	}
+}
+
+// Test that exactly one of the goroutines concurrently adding or removing the same value succeeds.
+func TestConcurrentContended(t *testing.T) {
+	const workers = 16
+	l := New(cmp.Compare[int])
+
+	for round := 0; round < 100; round++ {
+		var added, removed atomic.Int64
+		var wg sync.WaitGroup
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if l.Add(round) {
+					added.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if l.Remove(round) {
+					removed.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if added.Load() != 1 || removed.Load() != 1 {
+			t.Fatalf("round %d: %d successful adds and %d successful removes, want 1 and 1", round, added.Load(), removed.Load())
+		}
+	}
+	checkList(t, l, []int{})
+}
+
+func checkList(t *testing.T, l *List[int], es []int) {
+	t.Helper()
+
+	if n := l.Len(); n != len(es) {
+		t.Errorf("l.Len() = %d, want %d", n, len(es))
+		return
+	}
+	vs := []int{}
+	l.Do(func(v int) bool {
+		vs = append(vs, v)
+		return true
+	})
+	if !slices.Equal(vs, es) {
+		t.Errorf("l = %v, want %v", vs, es)
+	}
+}
@@ -0,0 +1,144 @@
+// Package gap contains an implementation of a gap buffer.
+//
+// A gap buffer is a sequence backed by a slice with a gap at the cursor position.
+// Insertions and deletions near the cursor take amortized O(1) time, moving the cursor takes time proportional to the distance moved.
+package gap
+
+import "fmt"
+
+// minGap is the minimum size of the gap after the buffer grows.
+const minGap = 16
+
+// Buffer represents a gap buffer.
+// Default value represents an empty buffer with the cursor at position 0 and is ready to use.
+type Buffer[T any] struct {
+	buf []T
+	// The gap is buf[start:end]; the cursor is at start
+	start, end int
+}
+
+// New returns an empty buffer with space for at least size elements.
+func New[T any](size int) *Buffer[T] {
+	size = max(size, 0)
+	return &Buffer[T]{buf: make([]T, size), end: size}
+}
+
+// From returns a buffer containing elements of s with the cursor at the end.
+func From[T any](s []T) *Buffer[T] {
+	b := New[T](len(s) + minGap)
+	b.Insert(s...)
+	return b
+}
+
+// Len returns the number of elements in the buffer.
+func (b *Buffer[T]) Len() int {
+	return len(b.buf) - (b.end - b.start)
+}
+
+// Empty returns whether the buffer is empty.
+func (b *Buffer[T]) Empty() bool {
+	return b.Len() == 0
+}
+
+// Cursor returns the position of the cursor.
+// The cursor is located immediately before the element with index Cursor().
+func (b *Buffer[T]) Cursor() int {
+	return b.start
+}
+
+// MoveCursor moves the cursor to the position pos in the range [0, b.Len()].
+func (b *Buffer[T]) MoveCursor(pos int) error {
+	if pos < 0 || pos > b.Len() {
+		return fmt.Errorf("cursor pos=%v out of bounds: [%v, %v]", pos, 0, b.Len())
+	}
+
+	switch {
+	case pos < b.start:
+		// move elements [pos, start) after the gap
+		n := b.start - pos
+		copy(b.buf[b.end-n:b.end], b.buf[pos:b.start])
+		// avoid loitering in the vacated slots that were not overwritten
+		clear(b.buf[pos:min(b.start, b.end-n)])
+		b.start, b.end = pos, b.end-n
+	case pos > b.start:
+		// move elements [end, end+n) before the gap
+		n := pos - b.start
+		copy(b.buf[b.start:pos], b.buf[b.end:b.end+n])
+		// avoid loitering in the vacated slots that were not overwritten
+		clear(b.buf[max(b.end, pos) : b.end+n])
+		b.start, b.end = pos, b.end+n
+	}
+	return nil
+}
+
+// grow ensures that the gap can hold at least n elements.
+func (b *Buffer[T]) grow(n int) {
+	if b.end-b.start >= n {
+		return
+	}
+
+	l := b.Len()
+	buf := make([]T, max(2*len(b.buf), l+n+minGap))
+	tail := len(b.buf) - b.end
+	copy(buf, b.buf[:b.start])
+	copy(buf[len(buf)-tail:], b.buf[b.end:])
+	b.buf, b.end = buf, len(buf)-tail
+}
+
+// Insert inserts the values vs at the cursor and moves the cursor after them.
+func (b *Buffer[T]) Insert(vs ...T) {
+	b.grow(len(vs))
+	b.start += copy(b.buf[b.start:], vs)
+}
+
+// Delete removes n elements following the cursor.
+func (b *Buffer[T]) Delete(n int) error {
+	if n < 0 || b.end+n > len(b.buf) {
+		return fmt.Errorf("delete n=%v out of bounds: [%v, %v]", n, 0, len(b.buf)-b.end)
+	}
+	clear(b.buf[b.end : b.end+n]) // avoid loitering
+	b.end += n
+	return nil
+}
+
+// DeleteBefore removes n elements preceding the cursor.
+func (b *Buffer[T]) DeleteBefore(n int) error {
+	if n < 0 || n > b.start {
+		return fmt.Errorf("delete n=%v out of bounds: [%v, %v]", n, 0, b.start)
+	}
+	clear(b.buf[b.start-n : b.start]) // avoid loitering
+	b.start -= n
+	return nil
+}
+
+// index returns the index in the backing slice of the element at index ind.
+func (b *Buffer[T]) index(ind int) int {
+	if ind < b.start {
+		return ind
+	}
+	return ind + b.end - b.start
+}
+
+// At returns the element at index ind.
+func (b *Buffer[T]) At(ind int) (T, error) {
+	if ind < 0 || ind >= b.Len() {
+		return *new(T), fmt.Errorf("index ind=%v out of bounds: [%v, %v]", ind, 0, b.Len()-1)
+	}
+	return b.buf[b.index(ind)], nil
+}
+
+// Slice returns a copy of the elements in the range [i, j).
+func (b *Buffer[T]) Slice(i, j int) ([]T, error) {
+	if i < 0 || j > b.Len() || i > j {
+		return nil, fmt.Errorf("slice bounds [%v:%v] out of range: [%v, %v]", i, j, 0, b.Len())
+	}
+
+	s := make([]T, 0, j-i)
+	if i < b.start {
+		s = append(s, b.buf[i:min(j, b.start)]...)
+	}
+	if j > b.start {
+		s = append(s, b.buf[b.index(max(i, b.start)):b.index(j-1)+1]...)
+	}
+	return s, nil
+}
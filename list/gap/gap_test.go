@@ -0,0 +1,153 @@
+package gap
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestZeroValue(t *testing.T) {
+	var b Buffer[rune]
+	b.Insert([]rune("hello")...)
+	checkBuffer(t, &b, "hello", 5)
+}
+
+func TestEdits(t *testing.T) {
+	b := From([]rune("hello world"))
+	checkBuffer(t, b, "hello world", 11)
+
+	if err := b.MoveCursor(5); err != nil {
+		t.Fatalf("b.MoveCursor(5) returned an error: %v", err)
+	}
+	b.Insert([]rune(",")...)
+	checkBuffer(t, b, "hello, world", 6)
+
+	if err := b.Delete(6); err != nil {
+		t.Fatalf("b.Delete(6) returned an error: %v", err)
+	}
+	checkBuffer(t, b, "hello,", 6)
+
+	if err := b.DeleteBefore(1); err != nil {
+		t.Fatalf("b.DeleteBefore(1) returned an error: %v", err)
+	}
+	b.Insert([]rune(" there")...)
+	checkBuffer(t, b, "hello there", 11)
+
+	if err := b.MoveCursor(0); err != nil {
+		t.Fatalf("b.MoveCursor(0) returned an error: %v", err)
+	}
+	b.Insert('>', ' ')
+	checkBuffer(t, b, "> hello there", 2)
+}
+
+func TestOutOfBounds(t *testing.T) {
+	b := From([]rune("abc"))
+	if err := b.MoveCursor(4); err == nil {
+		t.Errorf("b.MoveCursor(4) should return an error")
+	}
+	if err := b.MoveCursor(-1); err == nil {
+		t.Errorf("b.MoveCursor(-1) should return an error")
+	}
+	if err := b.Delete(1); err == nil {
+		t.Errorf("b.Delete(1) at the end should return an error")
+	}
+	if err := b.DeleteBefore(4); err == nil {
+		t.Errorf("b.DeleteBefore(4) should return an error")
+	}
+	if _, err := b.At(3); err == nil {
+		t.Errorf("b.At(3) should return an error")
+	}
+	if _, err := b.Slice(2, 1); err == nil {
+		t.Errorf("b.Slice(2, 1) should return an error")
+	}
+	checkBuffer(t, b, "abc", 3)
+}
+
+// Test the buffer against a slice under a random sequence of operations.
+func TestRandomized(t *testing.T) {
+	var b Buffer[rune]
+	rnd := rand.New(rand.NewSource(1))
+	var model []rune
+	cur := 0
+
+	for i := 0; i < 2000; i++ {
+		switch rnd.Intn(4) {
+		case 0:
+			cur = rnd.Intn(len(model) + 1)
+			_ = b.MoveCursor(cur)
+		case 1:
+			n := rnd.Intn(len(model) - cur + 1)
+			_ = b.Delete(n)
+			model = slices.Delete(model, cur, cur+n)
+		default:
+			r := rune('a' + rnd.Intn(26))
+			b.Insert(r)
+			model = slices.Insert(model, cur, r)
+			cur++
+		}
+		// inserted runes are never zero, so any in the gap were left behind
+		if i := slices.IndexFunc(b.buf[b.start:b.end], func(r rune) bool { return r != 0 }); i >= 0 {
+			t.Fatalf("gap slot %d holds %q, want zero", b.start+i, b.buf[b.start+i])
+		}
+	}
+	checkBuffer(t, &b, string(model), cur)
+}
+
+func TestMoveCursorTouchesOnlyMoved(t *testing.T) {
+	b := From([]rune("abcdef"))
+	b.Insert(make([]rune, 1000)...) // grow the gap
+	_ = b.DeleteBefore(1000)
+
+	// a sentinel in the middle of the gap must survive one-step moves
+	mid := (b.start + b.end) / 2
+	b.buf[mid] = '#'
+	for _, pos := range []int{5, 4, 5, 6} {
+		if err := b.MoveCursor(pos); err != nil {
+			t.Fatalf("b.MoveCursor(%d) returned an error: %v", pos, err)
+		}
+		if b.buf[mid] != '#' {
+			t.Fatalf("b.MoveCursor(%d) cleared the gap slot %d far from the moved elements", pos, mid)
+		}
+	}
+	b.buf[mid] = 0
+	checkBuffer(t, b, "abcdef", 6)
+}
+
+// Benchmark one-step cursor moves on a large buffer, which should not depend on its size.
+func BenchmarkMoveCursor(b *testing.B) {
+	for _, n := range []int{1 << 12, 1 << 20} {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			buf := From(make([]int, n))
+			buf.Insert(make([]int, n)...) // grow the gap to about the size of the content
+			for i := 0; i < b.N; i++ {
+				_ = buf.MoveCursor(buf.Cursor() - 1 + 2*(i%2))
+			}
+		})
+	}
+}
+
+func checkBuffer(t *testing.T, b *Buffer[rune], want string, cursor int) {
+	t.Helper()
+
+	rs := []rune(want)
+	if n := b.Len(); n != len(rs) {
+		t.Errorf("b.Len() = %d, want %d", n, len(rs))
+		return
+	}
+	if c := b.Cursor(); c != cursor {
+		t.Errorf("b.Cursor() = %d, want %d", c, cursor)
+	}
+	for i, r := range rs {
+		if v, err := b.At(i); err != nil || v != r {
+			t.Errorf("b.At(%d) = (%q, %v), want %q", i, v, err, r)
+		}
+	}
+	for i := 0; i <= len(rs); i++ {
+		for j := i; j <= len(rs); j++ {
+			if s, err := b.Slice(i, j); err != nil || string(s) != string(rs[i:j]) {
+				t.Errorf("b.Slice(%d, %d) = (%q, %v), want %q", i, j, string(s), err, string(rs[i:j]))
+			}
+		}
+	}
+}
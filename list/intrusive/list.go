@@ -0,0 +1,164 @@
+// Package intrusive contains an implementation of an intrusive doubly-linked circular list.
+//
+// Unlike [github.com/denpeshkov/datastructures/list/linked], the list does not allocate its elements.
+// Instead, a [Node] is embedded in the user's type and linked into the list directly.
+// A value can be an element of several lists at once by embedding a separate [Node] for each list.
+package intrusive
+
+// Node represents a link of an element in the list.
+// It is meant to be embedded in the element's type.
+// A copy of a linked element is not linked: the node records the element it belongs to.
+type Node[T any] struct {
+	owner      *T
+	l          *List[T]
+	prev, next *Node[T]
+}
+
+// Next returns the next element or nil.
+func (n *Node[T]) Next() *T {
+	if !n.Linked() || n.next == &n.l.root {
+		return nil
+	}
+	return n.next.owner
+}
+
+// Prev returns the previous element or nil.
+func (n *Node[T]) Prev() *T {
+	if !n.Linked() || n.prev == &n.l.root {
+		return nil
+	}
+	return n.prev.owner
+}
+
+// Linked returns whether the node is linked into a list.
+func (n *Node[T]) Linked() bool {
+	return n.l != nil && n.l.node(n.owner) == n
+}
+
+// List represents an intrusive doubly-linked circular list.
+type List[T any] struct {
+	// Sentinel node
+	// Head is root.next, tail is root.prev
+	root Node[T]
+	len  int
+	// node returns the node of an element used by this list.
+	node func(*T) *Node[T]
+}
+
+// New returns an initialized list.
+// The node function returns the node embedded in an element that is used to link it into this list.
+func New[T any](node func(*T) *Node[T]) *List[T] {
+	l := &List[T]{node: node}
+	l.root.prev = &l.root
+	l.root.next = &l.root
+	return l
+}
+
+// Front returns the first element of the list or nil if the list is empty.
+func (l *List[T]) Front() *T {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.next.owner
+}
+
+// Back returns the last element of the list or nil if the list is empty.
+func (l *List[T]) Back() *T {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.prev.owner
+}
+
+// has returns the node of element x and whether x is an element of the list l.
+// The owner check rejects copies of elements, whose nodes still point into the list.
+func (l *List[T]) has(x *T) (*Node[T], bool) {
+	n := l.node(x)
+	return n, n.l == l && n.owner == x
+}
+
+// insertAfter links unlinked element x after node p and returns x.
+// If x is already linked, nil is returned.
+func (l *List[T]) insertAfter(x *T, p *Node[T]) *T {
+	n := l.node(x)
+	if n.l != nil && n.owner == x {
+		return nil
+	}
+
+	n.owner = x
+	n.l = l
+	n.prev = p
+	n.next = p.next
+	n.prev.next = n
+	n.next.prev = n
+
+	l.len++
+
+	return x
+}
+
+// InsertFront inserts element x at the front of the list and returns x.
+// If x is already an element of a list, nil is returned.
+func (l *List[T]) InsertFront(x *T) *T {
+	return l.insertAfter(x, &l.root)
+}
+
+// InsertBack inserts element x at the back of the list and returns x.
+// If x is already an element of a list, nil is returned.
+func (l *List[T]) InsertBack(x *T) *T {
+	return l.insertAfter(x, l.root.prev)
+}
+
+// InsertBefore inserts element x immediately before mark and returns x.
+// If mark is not an element of the list l or x is already an element of a list, nil is returned.
+func (l *List[T]) InsertBefore(x, mark *T) *T {
+	p, ok := l.has(mark)
+	if !ok {
+		return nil
+	}
+	return l.insertAfter(x, p.prev)
+}
+
+// InsertAfter inserts element x immediately after mark and returns x.
+// If mark is not an element of the list l or x is already an element of a list, nil is returned.
+func (l *List[T]) InsertAfter(x, mark *T) *T {
+	p, ok := l.has(mark)
+	if !ok {
+		return nil
+	}
+	return l.insertAfter(x, p)
+}
+
+// Remove removes element x from list l if x is an element of list l.
+func (l *List[T]) Remove(x *T) {
+	n, ok := l.has(x)
+	if !ok {
+		return
+	}
+
+	n.prev.next = n.next
+	n.next.prev = n.prev
+
+	n.prev = nil  // avoid loitering
+	n.next = nil  // avoid loitering
+	n.l = nil     // avoid loitering
+	n.owner = nil // avoid loitering
+
+	l.len--
+}
+
+// Contains returns whether x is an element of the list l.
+func (l *List[T]) Contains(x *T) bool {
+	_, ok := l.has(x)
+	return ok
+}
+
+// Len returns the number of elements in the list.
+func (l *List[T]) Len() int {
+	return l.len
+}
+
+// Empty returns whether the list is empty.
+func (l *List[T]) Empty() bool {
+	return l.len == 0
+}
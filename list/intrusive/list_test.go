@@ -0,0 +1,197 @@
+package intrusive
+
+import (
+	"testing"
+)
+
+type item struct {
+	v     int
+	byAge Node[item]
+	byID  Node[item]
+}
+
+func byAge(x *item) *Node[item] { return &x.byAge }
+func byID(x *item) *Node[item]  { return &x.byID }
+
+func TestList(t *testing.T) {
+	l := New(byAge)
+	checkList(t, l, []*item{})
+
+	a, b, c, d := &item{v: 1}, &item{v: 2}, &item{v: 3}, &item{v: 4}
+
+	// Single element list
+	l.InsertFront(a)
+	checkList(t, l, []*item{a})
+	l.Remove(a)
+	checkList(t, l, []*item{})
+
+	// Bigger list
+	l.InsertFront(b)
+	l.InsertFront(a)
+	l.InsertBack(c)
+	l.InsertBack(d)
+	checkList(t, l, []*item{a, b, c, d})
+
+	l.Remove(b)
+	checkList(t, l, []*item{a, c, d})
+
+	l.InsertBefore(b, a) // insert before front
+	checkList(t, l, []*item{b, a, c, d})
+	l.Remove(b)
+	l.InsertBefore(b, c) // insert before middle
+	checkList(t, l, []*item{a, b, c, d})
+	l.Remove(b)
+	l.InsertAfter(b, d) // insert after back
+	checkList(t, l, []*item{a, c, d, b})
+
+	// Clear all elements by iterating
+	var next *item
+	for x := l.Front(); x != nil; x = next {
+		next = x.byAge.Next()
+		l.Remove(x)
+	}
+	checkList(t, l, []*item{})
+}
+
+func TestMultipleLists(t *testing.T) {
+	l1, l2 := New(byAge), New(byID)
+	a, b, c := &item{v: 1}, &item{v: 2}, &item{v: 3}
+
+	for _, x := range []*item{a, b, c} {
+		l1.InsertBack(x)
+		l2.InsertFront(x)
+	}
+	checkList(t, l1, []*item{a, b, c})
+	checkList(t, l2, []*item{c, b, a})
+
+	l1.Remove(b)
+	checkList(t, l1, []*item{a, c})
+	checkList(t, l2, []*item{c, b, a})
+}
+
+func TestInsertLinkedElement(t *testing.T) {
+	l1, l2 := New(byAge), New(byAge)
+	a := &item{v: 1}
+
+	if x := l1.InsertBack(a); x != a {
+		t.Errorf("l1.InsertBack(a) = %p, want %p", x, a)
+	}
+	if x := l2.InsertBack(a); x != nil {
+		t.Errorf("l2.InsertBack(a) = %p, want nil", x)
+	}
+	if x := l1.InsertFront(a); x != nil {
+		t.Errorf("l1.InsertFront(a) = %p, want nil", x)
+	}
+	checkList(t, l1, []*item{a})
+	checkList(t, l2, []*item{})
+}
+
+func TestRemoveElementFromDifferentList(t *testing.T) {
+	l1, l2 := New(byAge), New(byAge)
+	a, b := &item{v: 1}, &item{v: 2}
+	l1.InsertBack(a)
+	l2.InsertBack(b)
+
+	l2.Remove(a) // l2 should not change because a is not an element of l2
+	checkList(t, l1, []*item{a})
+	checkList(t, l2, []*item{b})
+
+	if x := l1.InsertBefore(&item{}, b); x != nil {
+		t.Errorf("l1.InsertBefore(x, b) = %p, want nil", x)
+	}
+	checkList(t, l1, []*item{a})
+}
+
+func TestRemovedElementIsUnlinked(t *testing.T) {
+	l := New(byAge)
+	a, b := &item{v: 1}, &item{v: 2}
+	l.InsertBack(a)
+	l.InsertBack(b)
+
+	l.Remove(a)
+	if a.byAge.Linked() {
+		t.Errorf("a.byAge.Linked() = true, want false")
+	}
+	if a.byAge.Next() != nil {
+		t.Errorf("a.byAge.Next() != nil")
+	}
+	if a.byAge.Prev() != nil {
+		t.Errorf("a.byAge.Prev() != nil")
+	}
+	if l.Contains(a) {
+		t.Errorf("l.Contains(a) = true, want false")
+	}
+}
+
+func TestCopiedElement(t *testing.T) {
+	l := New(byAge)
+	a, b, c := &item{v: 1}, &item{v: 2}, &item{v: 3}
+	l.InsertBack(a)
+	l.InsertBack(b)
+	l.InsertBack(c)
+
+	cp := *b // the copy's node still points into the list
+	if cp.byAge.Linked() || cp.byAge.Next() != nil || cp.byAge.Prev() != nil {
+		t.Errorf("copy of a linked element reports being linked")
+	}
+	if l.Contains(&cp) {
+		t.Errorf("l.Contains(&cp) = true, want false")
+	}
+	l.Remove(&cp) // must not unlink b
+	if x := l.InsertAfter(&item{}, &cp); x != nil {
+		t.Errorf("l.InsertAfter(x, &cp) = %p, want nil", x)
+	}
+	checkList(t, l, []*item{a, b, c})
+
+	// the copy can be linked on its own
+	if x := l.InsertFront(&cp); x != &cp {
+		t.Errorf("l.InsertFront(&cp) = %p, want %p", x, &cp)
+	}
+	checkList(t, l, []*item{&cp, a, b, c})
+}
+
+func TestNoAllocs(t *testing.T) {
+	l := New(byAge)
+	items := make([]item, 16)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		for i := range items {
+			l.InsertBack(&items[i])
+		}
+		for i := range items {
+			l.Remove(&items[i])
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("allocs = %v, want 0", allocs)
+	}
+}
+
+func checkList(t *testing.T, l *List[item], es []*item) {
+	t.Helper()
+
+	if n := l.Len(); n != len(es) {
+		t.Errorf("l.Len() = %d, want %d", n, len(es))
+		return
+	}
+	if l.Empty() != (len(es) == 0) {
+		t.Errorf("l.Empty() = %v, want %v", l.Empty(), len(es) == 0)
+	}
+
+	// forward
+	i := 0
+	for x := l.Front(); x != nil; x = l.node(x).Next() {
+		if x != es[i] {
+			t.Errorf("elt[%d] = %v, want %v", i, x.v, es[i].v)
+		}
+		i++
+	}
+	// backward
+	i = len(es) - 1
+	for x := l.Back(); x != nil; x = l.node(x).Prev() {
+		if x != es[i] {
+			t.Errorf("elt[%d] = %v, want %v", i, x.v, es[i].v)
+		}
+		i--
+	}
+}
@@ -0,0 +1,67 @@
+package linked
+
+import (
+	"errors"
+	"fmt"
+)
+
+// checkElement panics with a descriptive message if debug mode is enabled and e is not an element of the list l.
+// The op is the name of the operation being performed.
+func (l *Linked[T]) checkElement(op string, e *Element[T]) {
+	if !debug || e.l == l {
+		return
+	}
+	if e.l == nil {
+		panic(fmt.Sprintf("linked: %s: element %p is not an element of any list; it was removed or not created by a list", op, e))
+	}
+	panic(fmt.Sprintf("linked: %s: element %p belongs to list %p, not to list %p", op, e, e.l, l))
+}
+
+// Validate checks the invariants of the list and returns an error describing the first violation found.
+// It checks that the elements form a circle through the sentinel in both directions,
+// that the number of elements matches [Linked.Len] and that every element belongs to the list.
+func (l *Linked[T]) Validate() error {
+	root := &l.root
+	if root.next == nil && root.prev == nil {
+		if l.len != 0 {
+			return fmt.Errorf("uninitialized list has len=%v, want 0", l.len)
+		}
+		return nil
+	}
+	if root.next == nil || root.prev == nil {
+		return errors.New("sentinel is partially initialized")
+	}
+
+	n := 0
+	for e := root.next; e != root; e = e.next {
+		switch {
+		case n >= l.len:
+			return fmt.Errorf("forward traversal did not return to the sentinel after len=%v elements", l.len)
+		case e == nil:
+			return fmt.Errorf("element at index %v is nil", n)
+		case e.l != l:
+			return fmt.Errorf("element %p at index %v belongs to list %p, not to list %p", e, n, e.l, l)
+		case e.prev == nil || e.prev.next != e:
+			return fmt.Errorf("element %p at index %v is not linked back by its predecessor", e, n)
+		}
+		n++
+	}
+	if n != l.len {
+		return fmt.Errorf("forward traversal found %v elements, want len=%v", n, l.len)
+	}
+	if root.next.prev != root {
+		return errors.New("sentinel is not linked back by its successor")
+	}
+
+	n = 0
+	for e := root.prev; e != root; e = e.prev {
+		if n >= l.len || e == nil {
+			return fmt.Errorf("backward traversal did not return to the sentinel after len=%v elements", l.len)
+		}
+		n++
+	}
+	if n != l.len {
+		return fmt.Errorf("backward traversal found %v elements, want len=%v", n, l.len)
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+//go:build !linkeddebug
+
+package linked
+
+// debug enables checks that panic on misuse of elements, such as operating on removed elements or elements of another list.
+// Build with the linkeddebug tag to enable it.
+const debug = false
@@ -0,0 +1,6 @@
+//go:build linkeddebug
+
+package linked
+
+// debug enables checks that panic on misuse of elements, such as operating on removed elements or elements of another list.
+const debug = true
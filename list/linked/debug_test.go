@@ -0,0 +1,46 @@
+//go:build linkeddebug
+
+package linked
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDebugPanics(t *testing.T) {
+	tests := []struct {
+		name string
+		op   func(l, other *Linked[int])
+		msg  string
+	}{
+		{"RemoveRemoved", func(l, _ *Linked[int]) {
+			e := l.Front()
+			l.Remove(e)
+			l.Remove(e)
+		}, "Remove: element"},
+		{"RemoveForeign", func(l, other *Linked[int]) { l.Remove(other.Front()) }, "belongs to list"},
+		{"InsertBeforeRemoved", func(l, _ *Linked[int]) {
+			e := l.Front()
+			l.Remove(e)
+			l.InsertBefore(0, e)
+		}, "not an element of any list"},
+		{"InsertBeforeForeign", func(l, other *Linked[int]) { l.InsertBefore(0, other.Front()) }, "belongs to list"},
+		{"InsertAfterForeign", func(l, other *Linked[int]) { l.InsertAfter(0, other.Front()) }, "belongs to list"},
+		{"MoveToFrontForeign", func(l, other *Linked[int]) { l.MoveToFront(other.Front()) }, "MoveToFront"},
+		{"MoveToBackUnknown", func(l, _ *Linked[int]) { l.MoveToBack(new(Element[int])) }, "not an element of any list"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+				if r == nil {
+					t.Fatalf("no panic")
+				}
+				if msg, _ := r.(string); !strings.Contains(msg, test.msg) {
+					t.Errorf("panic message %q does not contain %q", msg, test.msg)
+				}
+			}()
+			test.op(FromSlice([]int{1, 2}), FromSlice([]int{3}))
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package linked
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+)
+
+// lazyInit initializes a zero list value.
+func (l *Linked[T]) lazyInit() {
+	if l.root.next == nil {
+		l.root.prev = &l.root
+		l.root.next = &l.root
+	}
+}
+
+// reset replaces the contents of the list l with the values of s.
+func (l *Linked[T]) reset(s []T) {
+	l.lazyInit()
+	Clear(l)
+	for _, v := range s {
+		l.InsertBack(v)
+	}
+}
+
+// String returns the values of the list formatted as [v1 v2 ...].
+// It implements the [fmt.Stringer] interface.
+func (l *Linked[T]) String() string {
+	return fmt.Sprint(l)
+}
+
+// Format implements the [fmt.Formatter] interface.
+// The list is formatted as [v1 v2 ...], where each value is formatted using the same verb and flags.
+// A nil list is formatted as <nil>.
+func (l *Linked[T]) Format(f fmt.State, verb rune) {
+	if l == nil {
+		fmt.Fprint(f, "<nil>")
+		return
+	}
+	format := fmt.FormatString(f, verb)
+	fmt.Fprint(f, "[")
+	for e, i := l.root.next, 0; i < l.len; e, i = e.next, i+1 {
+		if i > 0 {
+			fmt.Fprint(f, " ")
+		}
+		fmt.Fprintf(f, format, e.Value)
+	}
+	fmt.Fprint(f, "]")
+}
+
+// MarshalJSON encodes the list as a JSON array.
+// It implements the [json.Marshaler] interface.
+func (l *Linked[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ToSlice(l))
+}
+
+// UnmarshalJSON decodes a JSON array into the list, replacing its contents.
+// As is conventional, JSON null leaves the list unchanged.
+// It implements the [json.Unmarshaler] interface.
+func (l *Linked[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	var s []T
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	l.reset(s)
+	return nil
+}
+
+// MarshalBinary encodes the list using [encoding/gob].
+// It implements the [encoding.BinaryMarshaler] interface.
+func (l *Linked[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(ToSlice(l)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes the list encoded by [Linked.MarshalBinary], replacing its contents.
+// It implements the [encoding.BinaryUnmarshaler] interface.
+func (l *Linked[T]) UnmarshalBinary(data []byte) error {
+	var s []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&s); err != nil {
+		return err
+	}
+	l.reset(s)
+	return nil
+}
@@ -0,0 +1,113 @@
+package linked
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+type point struct {
+	X, Y int
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		l      fmt.Formatter
+		want   string
+	}{
+		{"%v", New[int](), "[]"},
+		{"%v", FromSlice([]int{1, 2, 3}), "[1 2 3]"},
+		{"%s", FromSlice([]string{"a", "b"}), "[a b]"},
+		{"%q", FromSlice([]string{"a", "b"}), `["a" "b"]`},
+		{"%v", FromSlice([]point{{1, 2}}), "[{1 2}]"},
+		{"%+v", FromSlice([]point{{1, 2}, {3, 4}}), "[{X:1 Y:2} {X:3 Y:4}]"},
+		{"%03d", FromSlice([]int{1, 2}), "[001 002]"},
+		{"%v", (*Linked[int])(nil), "<nil>"},
+	}
+	for _, test := range tests {
+		if s := fmt.Sprintf(test.format, test.l); s != test.want {
+			t.Errorf("fmt.Sprintf(%q, l) = %q, want %q", test.format, s, test.want)
+		}
+	}
+
+	if s := FromSlice([]int{1, 2}).String(); s != "[1 2]" {
+		t.Errorf("l.String() = %q, want %q", s, "[1 2]")
+	}
+	if s := (*Linked[int])(nil).String(); s != "<nil>" {
+		t.Errorf("nil list String() = %q, want %q", s, "<nil>")
+	}
+}
+
+func TestJSON(t *testing.T) {
+	testJSON(t, FromSlice([]int{1, 2, 3}), "[1,2,3]")
+	testJSON(t, FromSlice([]string{"a", "b"}), `["a","b"]`)
+	testJSON(t, FromSlice([]point{{1, 2}}), `[{"X":1,"Y":2}]`)
+	testJSON(t, New[int](), "[]")
+
+	// unmarshaling replaces the contents
+	l := FromSlice([]int{5, 6})
+	if err := json.Unmarshal([]byte("[7]"), l); err != nil {
+		t.Fatalf("json.Unmarshal() returned an error: %v", err)
+	}
+	if !Equal(l, FromSlice([]int{7})) {
+		t.Errorf("json.Unmarshal() = %v, want [7]", l)
+	}
+
+	// null leaves the list unchanged
+	if err := json.Unmarshal([]byte("null"), l); err != nil {
+		t.Fatalf("json.Unmarshal(null) returned an error: %v", err)
+	}
+	if !Equal(l, FromSlice([]int{7})) {
+		t.Errorf("json.Unmarshal(null) = %v, want [7]", l)
+	}
+
+	if err := json.Unmarshal([]byte(`{"a":1}`), l); err == nil {
+		t.Errorf("json.Unmarshal() of an object should return an error")
+	}
+}
+
+func testJSON[T comparable](t *testing.T, l *Linked[T], want string) {
+	t.Helper()
+
+	b, err := json.Marshal(l)
+	if err != nil {
+		t.Fatalf("json.Marshal(%v) returned an error: %v", l, err)
+	}
+	if string(b) != want {
+		t.Errorf("json.Marshal(%v) = %s, want %s", l, b, want)
+	}
+
+	var got Linked[T] // zero value must be usable as a target
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("json.Unmarshal(%s) returned an error: %v", b, err)
+	}
+	if !Equal(&got, l) {
+		t.Errorf("json.Unmarshal(%s) = %v, want %v", b, &got, l)
+	}
+}
+
+func TestGob(t *testing.T) {
+	testGob(t, FromSlice([]int{1, 2, 3}))
+	testGob(t, FromSlice([]string{"a", "b"}))
+	testGob(t, FromSlice([]point{{1, 2}, {3, 4}}))
+	testGob(t, New[float64]())
+}
+
+func testGob[T comparable](t *testing.T, l *Linked[T]) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(l); err != nil {
+		t.Fatalf("gob encoding of %v returned an error: %v", l, err)
+	}
+	got := FromSlice(make([]T, 5)) // existing contents are replaced
+	if err := gob.NewDecoder(&buf).Decode(got); err != nil {
+		t.Fatalf("gob decoding of %v returned an error: %v", l, err)
+	}
+	if !Equal(got, l) {
+		t.Errorf("gob round trip = %v, want %v", got, l)
+	}
+}
@@ -103,6 +103,7 @@ func (l *Linked[T]) InsertBack(v T) *Element[T] {
 // InsertBefore inserts a new element with value v immediately before p and returns e.
 // If p is not an element of the list l, nil is returned.
 func (l *Linked[T]) InsertBefore(v T, p *Element[T]) *Element[T] {
+	l.checkElement("InsertBefore", p)
 	if p.l != l {
 		return nil
 	}
@@ -112,6 +113,7 @@ func (l *Linked[T]) InsertBefore(v T, p *Element[T]) *Element[T] {
 // InsertAfter inserts a new element with value v immediately after p and returns e.
 // If p is not an element of the list l, nil is returned.
 func (l *Linked[T]) InsertAfter(v T, p *Element[T]) *Element[T] {
+	l.checkElement("InsertAfter", p)
 	if p.l != l {
 		return nil
 	}
@@ -120,6 +122,7 @@ func (l *Linked[T]) InsertAfter(v T, p *Element[T]) *Element[T] {
 
 // Remove removes element e from list l if e is an element of list l.
 func (l *Linked[T]) Remove(e *Element[T]) {
+	l.checkElement("Remove", e)
 	if e.l != l {
 		return
 	}
@@ -134,6 +137,62 @@ func (l *Linked[T]) Remove(e *Element[T]) {
 	l.len--
 }
 
+// move moves element e after element p.
+func (l *Linked[T]) move(e, p *Element[T]) {
+	if e == p {
+		return
+	}
+	e.prev.next = e.next
+	e.next.prev = e.prev
+
+	e.prev = p
+	e.next = p.next
+	e.prev.next = e
+	e.next.prev = e
+}
+
+// MoveToFront moves element e to the front of list l.
+// If e is not an element of l, the list is not modified.
+func (l *Linked[T]) MoveToFront(e *Element[T]) {
+	l.checkElement("MoveToFront", e)
+	if e.l != l || l.root.next == e {
+		return
+	}
+	l.move(e, &l.root)
+}
+
+// MoveToBack moves element e to the back of list l.
+// If e is not an element of l, the list is not modified.
+func (l *Linked[T]) MoveToBack(e *Element[T]) {
+	l.checkElement("MoveToBack", e)
+	if e.l != l || l.root.prev == e {
+		return
+	}
+	l.move(e, l.root.prev)
+}
+
+// MoveBefore moves element e to its new position before mark.
+// If e or mark is not an element of l, or e == mark, the list is not modified.
+func (l *Linked[T]) MoveBefore(e, mark *Element[T]) {
+	l.checkElement("MoveBefore", e)
+	l.checkElement("MoveBefore", mark)
+	if e.l != l || mark.l != l || e == mark {
+		return
+	}
+	l.move(e, mark.prev)
+}
+
+// MoveAfter moves element e to its new position after mark.
+// If e or mark is not an element of l, or e == mark, the list is not modified.
+func (l *Linked[T]) MoveAfter(e, mark *Element[T]) {
+	l.checkElement("MoveAfter", e)
+	l.checkElement("MoveAfter", mark)
+	if e.l != l || mark.l != l || e == mark {
+		return
+	}
+	l.move(e, mark)
+}
+
 // At returns an element at index ind.
 func (l *Linked[T]) At(ind int) (*Element[T], error) {
 	if ind >= l.len {
@@ -182,3 +241,136 @@ func Index[T comparable](l *Linked[T], v T) int {
 	}
 	return -1
 }
+
+// FindFunc returns the first element satisfying f(e.Value), or nil if none do.
+// The second parameter is true if the element is found; otherwise, it is false.
+func FindFunc[T any](l *Linked[T], f func(T) bool) (*Element[T], bool) {
+	for e, i := l.root.next, 0; i < l.len; e, i = e.next, i+1 {
+		if f(e.Value) {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+// IndexFunc returns the index of the first element satisfying f(e.Value), or -1 if none do.
+func IndexFunc[T any](l *Linked[T], f func(T) bool) int {
+	for e, i := l.root.next, 0; i < l.len; e, i = e.next, i+1 {
+		if f(e.Value) {
+			return i
+		}
+	}
+	return -1
+}
+
+// LastIndex returns the index of the last element with value v, or -1 if not present.
+func LastIndex[T comparable](l *Linked[T], v T) int {
+	for e, i := l.root.prev, l.len-1; i >= 0; e, i = e.prev, i-1 {
+		if e.Value == v {
+			return i
+		}
+	}
+	return -1
+}
+
+// Contains returns whether an element with value v is present in the list.
+func Contains[T comparable](l *Linked[T], v T) bool {
+	return Index(l, v) >= 0
+}
+
+// FromSlice returns a list containing the values of s in the same order.
+func FromSlice[T any](s []T) *Linked[T] {
+	l := New[T]()
+	for _, v := range s {
+		l.InsertBack(v)
+	}
+	return l
+}
+
+// ToSlice returns the values of the list as a slice.
+func ToSlice[T any](l *Linked[T]) []T {
+	s := make([]T, 0, l.len)
+	for e, i := l.root.next, 0; i < l.len; e, i = e.next, i+1 {
+		s = append(s, e.Value)
+	}
+	return s
+}
+
+// Clone returns a shallow copy of the list.
+func Clone[T any](l *Linked[T]) *Linked[T] {
+	c := New[T]()
+	for e, i := l.root.next, 0; i < l.len; e, i = e.next, i+1 {
+		c.InsertBack(e.Value)
+	}
+	return c
+}
+
+// Equal returns whether the lists contain the same values in the same order.
+func Equal[T comparable](l1, l2 *Linked[T]) bool {
+	return EqualFunc(l1, l2, func(v1, v2 T) bool { return v1 == v2 })
+}
+
+// EqualFunc returns whether the lists contain the same values in the same order, using eq to compare values.
+func EqualFunc[T1, T2 any](l1 *Linked[T1], l2 *Linked[T2], eq func(T1, T2) bool) bool {
+	if l1.len != l2.len {
+		return false
+	}
+	for e1, e2, i := l1.root.next, l2.root.next, 0; i < l1.len; e1, e2, i = e1.next, e2.next, i+1 {
+		if !eq(e1.Value, e2.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Map returns a list of the results of applying f to each value of the list l.
+func Map[T, U any](l *Linked[T], f func(T) U) *Linked[U] {
+	m := New[U]()
+	for e, i := l.root.next, 0; i < l.len; e, i = e.next, i+1 {
+		m.InsertBack(f(e.Value))
+	}
+	return m
+}
+
+// Filter returns a list of the values of the list l satisfying f.
+func Filter[T any](l *Linked[T], f func(T) bool) *Linked[T] {
+	r := New[T]()
+	for e, i := l.root.next, 0; i < l.len; e, i = e.next, i+1 {
+		if f(e.Value) {
+			r.InsertBack(e.Value)
+		}
+	}
+	return r
+}
+
+// Reduce returns the result of combining the values of the list l from front to back using f, starting with init.
+func Reduce[T, U any](l *Linked[T], init U, f func(U, T) U) U {
+	acc := init
+	for e, i := l.root.next, 0; i < l.len; e, i = e.next, i+1 {
+		acc = f(acc, e.Value)
+	}
+	return acc
+}
+
+// RemoveFunc removes from the list l all the elements satisfying f(e.Value) and returns the number of removed elements.
+func RemoveFunc[T any](l *Linked[T], f func(T) bool) int {
+	n := 0
+	for e := l.Front(); e != nil; {
+		next := e.Next()
+		if f(e.Value) {
+			l.Remove(e)
+			n++
+		}
+		e = next
+	}
+	return n
+}
+
+// Clear removes all the elements from the list l.
+func Clear[T any](l *Linked[T]) {
+	for e := l.Front(); e != nil; {
+		next := e.Next()
+		l.Remove(e)
+		e = next
+	}
+}
@@ -2,6 +2,7 @@
 package linked
 
 import (
+	"fmt"
 	"testing"
 )
 
@@ -71,6 +72,7 @@ func TestList(t *testing.T) {
 }
 
 func TestRemove(t *testing.T) {
+	skipInDebug(t)
 	l := New[any]()
 	e1 := l.InsertBack(1)
 	e2 := l.InsertBack(2)
@@ -83,6 +85,7 @@ func TestRemove(t *testing.T) {
 }
 
 func TestInsertElementFromDifferentList(t *testing.T) {
+	skipInDebug(t)
 	l1 := New[any]()
 	l1.InsertBack(1)
 	l1.InsertBack(2)
@@ -123,6 +126,7 @@ func TestRemovedElementIsUnlinked(t *testing.T) {
 
 // Test that a list l is not modified when calling InsertBefore with a mark that is not an element of l.
 func TestInsertBeforeUnknownMark(t *testing.T) {
+	skipInDebug(t)
 	l := New[any]()
 	l.InsertBack(1)
 	l.InsertBack(2)
@@ -133,6 +137,7 @@ func TestInsertBeforeUnknownMark(t *testing.T) {
 
 // Test that a list l is not modified when calling InsertAfter with a mark that is not an element of l.
 func TestInsertAfterUnknownMark(t *testing.T) {
+	skipInDebug(t)
 	l := New[any]()
 	l.InsertBack(1)
 	l.InsertBack(2)
@@ -141,6 +146,188 @@ func TestInsertAfterUnknownMark(t *testing.T) {
 	checkList(t, l, []any{1, 2, 3})
 }
 
+func TestMove(t *testing.T) {
+	l := New[any]()
+	e1 := l.InsertBack(1)
+	e2 := l.InsertBack(2)
+	e3 := l.InsertBack(3)
+	checkListPointers(t, l, []*Element[any]{e1, e2, e3})
+
+	l.MoveToFront(e1)
+	checkListPointers(t, l, []*Element[any]{e1, e2, e3})
+	l.MoveToFront(e2)
+	checkListPointers(t, l, []*Element[any]{e2, e1, e3})
+	l.MoveToFront(e3)
+	checkListPointers(t, l, []*Element[any]{e3, e2, e1})
+
+	l.MoveToBack(e1)
+	checkListPointers(t, l, []*Element[any]{e3, e2, e1})
+	l.MoveToBack(e3)
+	checkListPointers(t, l, []*Element[any]{e2, e1, e3})
+	l.MoveToBack(e2)
+	checkListPointers(t, l, []*Element[any]{e1, e3, e2})
+
+	l.MoveBefore(e2, e1)
+	checkListPointers(t, l, []*Element[any]{e2, e1, e3})
+	l.MoveBefore(e2, e2)
+	checkListPointers(t, l, []*Element[any]{e2, e1, e3})
+	l.MoveBefore(e2, e3)
+	checkListPointers(t, l, []*Element[any]{e1, e2, e3})
+
+	l.MoveAfter(e1, e3)
+	checkListPointers(t, l, []*Element[any]{e2, e3, e1})
+	l.MoveAfter(e1, e2)
+	checkListPointers(t, l, []*Element[any]{e2, e1, e3})
+	l.MoveAfter(e3, e3)
+	checkListPointers(t, l, []*Element[any]{e2, e1, e3})
+}
+
+// Test that a list l is not modified when moving an element that is not an element of l.
+func TestMoveUnknownElement(t *testing.T) {
+	skipInDebug(t)
+	l1 := New[any]()
+	e1 := l1.InsertBack(1)
+
+	l2 := New[any]()
+	e2 := l2.InsertBack(2)
+	l2.InsertBack(3)
+
+	l1.MoveToFront(e2)
+	l1.MoveToBack(e2)
+	l1.MoveBefore(e2, e1)
+	l1.MoveAfter(e1, e2)
+	checkListPointers(t, l1, []*Element[any]{e1})
+	checkList(t, l2, []any{2, 3})
+}
+
+func TestSliceConversion(t *testing.T) {
+	l := FromSlice([]any{1, 2, 3})
+	checkList(t, l, []any{1, 2, 3})
+
+	s := ToSlice(l)
+	if len(s) != 3 || s[0] != 1 || s[1] != 2 || s[2] != 3 {
+		t.Errorf("ToSlice(l) = %v, want [1 2 3]", s)
+	}
+	if s := ToSlice(New[any]()); len(s) != 0 {
+		t.Errorf("ToSlice(empty) = %v, want []", s)
+	}
+}
+
+func TestClone(t *testing.T) {
+	l := FromSlice([]any{1, 2, 3})
+	c := Clone(l)
+	checkList(t, c, []any{1, 2, 3})
+
+	c.Remove(c.Front())
+	checkList(t, l, []any{1, 2, 3})
+	checkList(t, c, []any{2, 3})
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		l1, l2 []int
+		eq     bool
+	}{
+		{nil, nil, true},
+		{[]int{1}, nil, false},
+		{[]int{1, 2}, []int{1, 2}, true},
+		{[]int{1, 2}, []int{2, 1}, false},
+		{[]int{1, 2}, []int{1, 2, 3}, false},
+	}
+	for _, test := range tests {
+		if eq := Equal(FromSlice(test.l1), FromSlice(test.l2)); eq != test.eq {
+			t.Errorf("Equal(%v, %v) = %v, want %v", test.l1, test.l2, eq, test.eq)
+		}
+	}
+
+	l1, l2 := FromSlice([]int{1, 2}), FromSlice([]string{"1", "2"})
+	if !EqualFunc(l1, l2, func(v1 int, v2 string) bool { return fmt.Sprint(v1) == v2 }) {
+		t.Errorf("EqualFunc(%v, %q) = false, want true", ToSlice(l1), ToSlice(l2))
+	}
+}
+
+func TestMapFilterReduce(t *testing.T) {
+	l := FromSlice([]int{1, 2, 3, 4})
+
+	m := Map(l, func(v int) any { return v * v })
+	checkList(t, m, []any{1, 4, 9, 16})
+
+	f := Map(Filter(l, func(v int) bool { return v%2 == 0 }), func(v int) any { return v })
+	checkList(t, f, []any{2, 4})
+
+	if sum := Reduce(l, 0, func(acc, v int) int { return acc + v }); sum != 10 {
+		t.Errorf("Reduce(l, +) = %d, want 10", sum)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	l := FromSlice([]int{1, 2, 3, 2, 1})
+	even := func(v int) bool { return v%2 == 0 }
+
+	if e, ok := FindFunc(l, even); !ok || e != l.Front().Next() {
+		t.Errorf("FindFunc(l, even) = (%p, %v), want (%p, true)", e, ok, l.Front().Next())
+	}
+	if e, ok := FindFunc(l, func(v int) bool { return v > 3 }); ok || e != nil {
+		t.Errorf("FindFunc(l, >3) = (%p, %v), want (nil, false)", e, ok)
+	}
+	if i := IndexFunc(l, even); i != 1 {
+		t.Errorf("IndexFunc(l, even) = %d, want 1", i)
+	}
+	if i := LastIndex(l, 2); i != 3 {
+		t.Errorf("LastIndex(l, 2) = %d, want 3", i)
+	}
+	if i := LastIndex(l, 4); i != -1 {
+		t.Errorf("LastIndex(l, 4) = %d, want -1", i)
+	}
+	if !Contains(l, 3) || Contains(l, 4) {
+		t.Errorf("Contains(l, 3) = %v, Contains(l, 4) = %v; want true, false", Contains(l, 3), Contains(l, 4))
+	}
+}
+
+func TestRemoveFuncClear(t *testing.T) {
+	l := FromSlice([]any{1, 2, 3, 4, 5})
+	if n := RemoveFunc(l, func(v any) bool { return v.(int)%2 == 1 }); n != 3 {
+		t.Errorf("RemoveFunc(l, odd) = %d, want 3", n)
+	}
+	checkList(t, l, []any{2, 4})
+
+	e := l.Front()
+	Clear(l)
+	checkListPointers(t, l, []*Element[any]{})
+	if e.Next() != nil || e.Prev() != nil {
+		t.Errorf("element of a cleared list is still linked")
+	}
+	l.InsertBack(1)
+	checkList(t, l, []any{1})
+}
+
+func TestValidate(t *testing.T) {
+	l := FromSlice([]int{1, 2, 3})
+	if err := l.Validate(); err != nil {
+		t.Errorf("l.Validate() = %v, want nil", err)
+	}
+	if err := new(Linked[int]).Validate(); err != nil {
+		t.Errorf("Validate() of zero list = %v, want nil", err)
+	}
+
+	tests := []struct {
+		name    string
+		corrupt func(l *Linked[int])
+	}{
+		{"len", func(l *Linked[int]) { l.len++ }},
+		{"owner", func(l *Linked[int]) { l.Front().l = New[int]() }},
+		{"prev", func(l *Linked[int]) { l.Back().prev = l.Back() }},
+		{"next", func(l *Linked[int]) { l.Front().next = l.Front() }},
+	}
+	for _, test := range tests {
+		l := FromSlice([]int{1, 2, 3})
+		test.corrupt(l)
+		if err := l.Validate(); err == nil {
+			t.Errorf("Validate() of list with corrupted %s = nil, want error", test.name)
+		}
+	}
+}
+
 /* func TestIterator(t *testing.T) {
 	l := NewLinked[any]()
 
@@ -159,6 +346,10 @@ func checkListLen[T any](t *testing.T, l *Linked[T], len int) bool {
 func checkListPointers[T any](t *testing.T, l *Linked[T], es []*Element[T]) {
 	root := &l.root
 
+	if err := l.Validate(); err != nil {
+		t.Errorf("l.Validate() = %v", err)
+	}
+
 	if !checkListLen(t, l, len(es)) {
 		return
 	}
@@ -215,3 +406,10 @@ func checkList(t *testing.T, l *Linked[any], es []any) {
 		i++
 	}
 }
+
+// skipInDebug skips tests of operations that panic in debug mode.
+func skipInDebug(t *testing.T) {
+	if debug {
+		t.Skip("misuse of elements panics in debug mode")
+	}
+}
@@ -0,0 +1,169 @@
+package linked
+
+// Ring is a circular view of a list positioned at one of its elements, similar to [container/ring].
+// Moving through a ring wraps around from the back of the list to the front and vice versa.
+// An empty list has an empty ring, which is positioned at no element.
+type Ring[T any] struct {
+	l *Linked[T]
+	e *Element[T]
+}
+
+// Ring returns a circular view of the list positioned at the first element.
+func (l *Linked[T]) Ring() *Ring[T] {
+	l.lazyInit()
+	return &Ring[T]{l, l.Front()}
+}
+
+// List returns the list the ring is a view of.
+func (r *Ring[T]) List() *Linked[T] {
+	return r.l
+}
+
+// Element returns the element the ring is positioned at, or nil if the ring is empty.
+func (r *Ring[T]) Element() *Element[T] {
+	return r.e
+}
+
+// Len returns the number of elements in the ring.
+func (r *Ring[T]) Len() int {
+	return r.l.len
+}
+
+// next returns the element following e, skipping the sentinel.
+func (r *Ring[T]) next(e *Element[T]) *Element[T] {
+	if e.next == &r.l.root {
+		return r.l.root.next
+	}
+	return e.next
+}
+
+// prev returns the element preceding e, skipping the sentinel.
+func (r *Ring[T]) prev(e *Element[T]) *Element[T] {
+	if e.prev == &r.l.root {
+		return r.l.root.prev
+	}
+	return e.prev
+}
+
+// Next returns the ring positioned at the next element.
+func (r *Ring[T]) Next() *Ring[T] {
+	return r.Move(1)
+}
+
+// Prev returns the ring positioned at the previous element.
+func (r *Ring[T]) Prev() *Ring[T] {
+	return r.Move(-1)
+}
+
+// Move returns the ring positioned n % r.Len() elements backward (n < 0) or forward (n >= 0).
+func (r *Ring[T]) Move(n int) *Ring[T] {
+	if r.e == nil || r.e.l != r.l {
+		return &Ring[T]{r.l, r.l.Front()}
+	}
+
+	e := r.e
+	n %= r.l.len
+	// move in the shorter direction
+	if n > r.l.len/2 {
+		n -= r.l.len
+	} else if n < -r.l.len/2 {
+		n += r.l.len
+	}
+	for ; n < 0; n++ {
+		e = r.prev(e)
+	}
+	for ; n > 0; n-- {
+		e = r.next(e)
+	}
+	return &Ring[T]{r.l, e}
+}
+
+// Do calls f on each value of the ring, starting at the element the ring is positioned at.
+func (r *Ring[T]) Do(f func(v T)) {
+	if r.e == nil || r.e.l != r.l {
+		return
+	}
+	for e, i := r.e, 0; i < r.l.len; e, i = r.next(e), i+1 {
+		f(e.Value)
+	}
+}
+
+// transfer moves element e from its list into list l after element p.
+func (l *Linked[T]) transfer(e, p *Element[T]) {
+	from := e.l
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	from.len--
+
+	l.insertAfter(e, p)
+}
+
+// Unlink removes n % r.Len() elements from the ring, starting at the element following the current one,
+// and returns a ring over a new list holding the removed elements, positioned at the first of them.
+// The removed elements keep their identity and become elements of the new list.
+// If no elements are removed, nil is returned.
+func (r *Ring[T]) Unlink(n int) *Ring[T] {
+	if n <= 0 || r.e == nil || r.e.l != r.l {
+		return nil
+	}
+	n %= r.l.len
+	if n == 0 {
+		return nil
+	}
+
+	s := New[T]()
+	e := r.next(r.e)
+	for i := 0; i < n; i++ {
+		next := r.next(e)
+		s.transfer(e, s.root.prev)
+		e = next
+	}
+	return s.Ring()
+}
+
+// Link connects ring r with ring s, so that the element following the current element of r becomes the current element of s.
+//
+// If r and s are views of different lists, all the elements of s, in ring order starting at its current element,
+// are moved into the list of r after its current element, leaving the list of s empty.
+// The result is the ring positioned at the element that followed the current element of r before the operation.
+// If r is empty, the result is the ring positioned at the first moved element.
+// If s is empty, the lists are not modified.
+//
+// If r and s are views of the same list, linking them removes the elements between the current elements of r and s,
+// as in [Ring.Unlink]. The result is a ring over the removed elements, or nil if there are none.
+func (r *Ring[T]) Link(s *Ring[T]) *Ring[T] {
+	if s.e == nil || s.e.l != s.l {
+		return r.Next()
+	}
+
+	if r.l == s.l {
+		n := 0
+		for e := r.next(r.e); e != s.e; e = r.next(e) {
+			n++
+		}
+		return r.Unlink(n)
+	}
+
+	p := &r.l.root
+	if r.e != nil && r.e.l == r.l {
+		p = r.e
+	}
+	next := &Ring[T]{r.l, p.next}
+
+	es := make([]*Element[T], 0, s.l.len)
+	for e, i := s.e, 0; i < s.l.len; e, i = s.next(e), i+1 {
+		es = append(es, e)
+	}
+	for _, e := range es {
+		r.l.transfer(e, p)
+		p = e
+	}
+
+	if r.e == nil {
+		return r.l.Ring()
+	}
+	if next.e == &r.l.root {
+		next.e = r.l.Front()
+	}
+	return next
+}
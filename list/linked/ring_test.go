@@ -0,0 +1,110 @@
+package linked
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRingMove(t *testing.T) {
+	r := FromSlice([]int{0, 1, 2, 3, 4}).Ring()
+	checkRing(t, r, []int{0, 1, 2, 3, 4})
+
+	tests := []struct {
+		n    int
+		want int
+	}{
+		{0, 0}, {1, 1}, {4, 4}, {5, 0}, {7, 2}, {-1, 4}, {-6, 4}, {-10, 0},
+	}
+	for _, test := range tests {
+		if v := r.Move(test.n).Element().Value; v != test.want {
+			t.Errorf("r.Move(%d) is at %d, want %d", test.n, v, test.want)
+		}
+	}
+	checkRing(t, r.Next().Next(), []int{2, 3, 4, 0, 1})
+	checkRing(t, r.Prev(), []int{4, 0, 1, 2, 3})
+
+	empty := New[int]().Ring()
+	checkRing(t, empty, []int{})
+	checkRing(t, empty.Move(3), []int{})
+	if empty.Element() != nil {
+		t.Errorf("empty.Element() != nil")
+	}
+}
+
+func TestRingUnlink(t *testing.T) {
+	l := FromSlice([]int{0, 1, 2, 3, 4})
+	r := l.Ring().Move(3)
+
+	e := l.Front() // element 0 is removed by wrapping around
+	s := r.Unlink(2)
+	checkRing(t, r, []int{3, 1, 2})
+	checkRing(t, s, []int{4, 0})
+	if s.Element().Next() != e || e.l != s.List() {
+		t.Errorf("removed element 0 is not moved into the new list")
+	}
+	checkListPointers(t, l, []*Element[int]{l.Front(), l.Front().Next(), l.Back()})
+
+	if s := r.Unlink(3); s != nil {
+		t.Errorf("r.Unlink(r.Len()) = %v, want nil", s)
+	}
+	if s := r.Unlink(0); s != nil {
+		t.Errorf("r.Unlink(0) = %v, want nil", s)
+	}
+	checkRing(t, r, []int{3, 1, 2})
+}
+
+func TestRingLink(t *testing.T) {
+	// different lists
+	r := FromSlice([]int{0, 1, 2}).Ring()
+	s := FromSlice([]int{10, 11, 12}).Ring().Next()
+	next := r.Link(s)
+	checkRing(t, r, []int{0, 11, 12, 10, 1, 2})
+	checkRing(t, next, []int{1, 2, 0, 11, 12, 10})
+	checkRing(t, s.List().Ring(), []int{})
+	if err := r.List().Validate(); err != nil {
+		t.Errorf("r.List().Validate() = %v", err)
+	}
+
+	// same list
+	removed := r.Link(r.Move(4))
+	checkRing(t, r, []int{0, 1, 2})
+	checkRing(t, removed, []int{11, 12, 10})
+
+	// into an empty ring
+	empty := New[int]().Ring()
+	checkRing(t, empty.Link(removed), []int{11, 12, 10})
+}
+
+// Test the ring by solving the Josephus problem: every k-th of n people is eliminated.
+func TestRingJosephus(t *testing.T) {
+	const n, k = 7, 3
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i + 1
+	}
+
+	var order []int
+	r := FromSlice(s).Ring().Move(-1)
+	for r.Len() > 1 {
+		r = r.Move(k - 1)
+		order = append(order, r.Unlink(1).Element().Value)
+	}
+	order = append(order, r.Element().Value)
+
+	if want := []int{3, 6, 2, 7, 5, 1, 4}; !slices.Equal(order, want) {
+		t.Errorf("elimination order = %v, want %v", order, want)
+	}
+}
+
+func checkRing(t *testing.T, r *Ring[int], want []int) {
+	t.Helper()
+
+	if n := r.Len(); n != len(want) {
+		t.Errorf("r.Len() = %d, want %d", n, len(want))
+	}
+	got := []int{}
+	r.Do(func(v int) { got = append(got, v) })
+	if !slices.Equal(got, want) {
+		t.Errorf("r.Do() visited %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,168 @@
+// Package persistent contains an implementation of a persistent immutable singly-linked list.
+//
+// Lists are never modified after construction, so they can be safely shared between goroutines.
+// Every operation returns a new version of a list sharing structure with the original one.
+package persistent
+
+// List represents a persistent immutable list.
+// A nil *List represents an empty list and is ready to use.
+type List[T any] struct {
+	head T
+	tail *List[T]
+	len  int
+}
+
+// Cons returns a list with value v prepended to the list l.
+func Cons[T any](v T, l *List[T]) *List[T] {
+	return &List[T]{head: v, tail: l, len: l.Len() + 1}
+}
+
+// From returns a list containing the values vs in the same order.
+func From[T any](vs ...T) *List[T] {
+	var l *List[T]
+	for i := len(vs) - 1; i >= 0; i-- {
+		l = Cons(vs[i], l)
+	}
+	return l
+}
+
+// Cons returns a list with value v prepended to the list l.
+func (l *List[T]) Cons(v T) *List[T] {
+	return Cons(v, l)
+}
+
+// Head returns the first element of the list.
+// The second parameter is false if the list is empty.
+func (l *List[T]) Head() (T, bool) {
+	if l == nil {
+		return *new(T), false
+	}
+	return l.head, true
+}
+
+// Tail returns the list without its first element.
+// The tail of an empty list is an empty list.
+func (l *List[T]) Tail() *List[T] {
+	if l == nil {
+		return nil
+	}
+	return l.tail
+}
+
+// Len returns the number of elements in the list.
+func (l *List[T]) Len() int {
+	if l == nil {
+		return 0
+	}
+	return l.len
+}
+
+// Empty returns whether the list is empty.
+func (l *List[T]) Empty() bool {
+	return l == nil
+}
+
+// Slice returns the elements of the list as a slice.
+func (l *List[T]) Slice() []T {
+	s := make([]T, 0, l.Len())
+	for ; l != nil; l = l.tail {
+		s = append(s, l.head)
+	}
+	return s
+}
+
+// Do calls f sequentially for each element in the list.
+// If f returns false, Do stops the iteration.
+func (l *List[T]) Do(f func(v T) bool) {
+	for ; l != nil; l = l.tail {
+		if !f(l.head) {
+			return
+		}
+	}
+}
+
+// Reverse returns the list with elements in reverse order.
+func (l *List[T]) Reverse() *List[T] {
+	var r *List[T]
+	for ; l != nil; l = l.tail {
+		r = Cons(l.head, r)
+	}
+	return r
+}
+
+// Append returns the concatenation of the lists l and other.
+// The result shares other, while the elements of l are copied.
+func (l *List[T]) Append(other *List[T]) *List[T] {
+	if other == nil {
+		return l
+	}
+	r := other
+	s := l.Slice()
+	for i := len(s) - 1; i >= 0; i-- {
+		r = Cons(s[i], r)
+	}
+	return r
+}
+
+// Filter returns the list of elements for which f returns true.
+// The longest suffix of l whose elements all satisfy f is shared with the result.
+func (l *List[T]) Filter(f func(v T) bool) *List[T] {
+	var keep []T
+	// suffix is the start of the shared suffix of elements satisfying f
+	suffix := l
+	for e := l; e != nil; e = e.tail {
+		if !f(e.head) {
+			for p := suffix; p != e; p = p.tail {
+				keep = append(keep, p.head)
+			}
+			suffix = e.tail
+		}
+	}
+	r := suffix
+	for i := len(keep) - 1; i >= 0; i-- {
+		r = Cons(keep[i], r)
+	}
+	return r
+}
+
+// Map returns the list of results of applying f to each element of the list l.
+func Map[T, U any](l *List[T], f func(v T) U) *List[U] {
+	s := l.Slice()
+	var r *List[U]
+	for i := len(s) - 1; i >= 0; i-- {
+		r = Cons(f(s[i]), r)
+	}
+	return r
+}
+
+// Fold returns the result of combining the elements of the list l from front to back using f, starting with init.
+func Fold[T, U any](l *List[T], init U, f func(acc U, v T) U) U {
+	acc := init
+	for ; l != nil; l = l.tail {
+		acc = f(acc, l.head)
+	}
+	return acc
+}
+
+// Equal returns whether the lists a and b contain the same elements in the same order.
+// Shared suffixes are compared in constant time.
+func Equal[T comparable](a, b *List[T]) bool {
+	return EqualFunc(a, b, func(x, y T) bool { return x == y })
+}
+
+// EqualFunc returns whether the lists a and b contain the same elements in the same order, using eq to compare elements.
+// Shared suffixes are compared in constant time.
+func EqualFunc[T, U any](a *List[T], b *List[U], eq func(x T, y U) bool) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+	for ; a != nil; a, b = a.tail, b.tail {
+		if any(a) == any(b) {
+			return true
+		}
+		if !eq(a.head, b.head) {
+			return false
+		}
+	}
+	return true
+}
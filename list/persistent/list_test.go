@@ -0,0 +1,135 @@
+package persistent
+
+import (
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestEmpty(t *testing.T) {
+	var l *List[int]
+	checkList(t, l, []int{})
+	if v, ok := l.Head(); ok || v != 0 {
+		t.Errorf("l.Head() = (%d, %v), want (0, false)", v, ok)
+	}
+	if l.Tail() != nil {
+		t.Errorf("l.Tail() of an empty list is not empty")
+	}
+}
+
+func TestConsHeadTail(t *testing.T) {
+	l1 := From(2, 3)
+	l2 := l1.Cons(1)
+	l3 := Cons(0, l1)
+
+	checkList(t, l1, []int{2, 3})
+	checkList(t, l2, []int{1, 2, 3})
+	checkList(t, l3, []int{0, 2, 3})
+
+	if v, ok := l2.Head(); !ok || v != 1 {
+		t.Errorf("l2.Head() = (%d, %v), want (1, true)", v, ok)
+	}
+	if l2.Tail() != l1 || l3.Tail() != l1 {
+		t.Errorf("tails of l2 and l3 are not shared with l1")
+	}
+}
+
+func TestReverseAppend(t *testing.T) {
+	a, b := From(1, 2, 3), From(4, 5)
+
+	checkList(t, a.Reverse(), []int{3, 2, 1})
+	ab := a.Append(b)
+	checkList(t, ab, []int{1, 2, 3, 4, 5})
+	if ab.Tail().Tail().Tail() != b {
+		t.Errorf("a.Append(b) does not share b")
+	}
+	checkList(t, a, []int{1, 2, 3})
+	checkList(t, b, []int{4, 5})
+}
+
+func TestMapFilterFold(t *testing.T) {
+	l := From(1, 2, 3, 4, 5, 6)
+
+	checkList(t, l.Filter(func(v int) bool { return v%2 == 0 }), []int{2, 4, 6})
+	if f := l.Filter(func(v int) bool { return v > 0 }); f != l {
+		t.Errorf("l.Filter() keeping all the elements does not return l")
+	}
+	f := l.Filter(func(v int) bool { return v != 2 })
+	checkList(t, f, []int{1, 3, 4, 5, 6})
+	if f.Tail() != l.Tail().Tail() {
+		t.Errorf("l.Filter() does not share the suffix of l")
+	}
+
+	s := Map(l, strconv.Itoa)
+	if got, want := s.Slice(), []string{"1", "2", "3", "4", "5", "6"}; !slices.Equal(got, want) {
+		t.Errorf("Map(l, strconv.Itoa) = %v, want %v", got, want)
+	}
+
+	if sum := Fold(l, 0, func(acc, v int) int { return acc + v }); sum != 21 {
+		t.Errorf("Fold(l, +) = %d, want 21", sum)
+	}
+}
+
+func TestEqual(t *testing.T) {
+	shared := From(3, 4)
+	tests := []struct {
+		a, b *List[int]
+		eq   bool
+	}{
+		{nil, nil, true},
+		{From(1), nil, false},
+		{From(1, 2), From(1, 2), true},
+		{From(1, 2), From(1, 3), false},
+		{From(1, 2), From(1, 2, 3), false},
+		{shared.Cons(2).Cons(1), shared.Cons(2).Cons(1), true},
+		{shared.Cons(2), shared.Cons(1), false},
+	}
+	for _, test := range tests {
+		if eq := Equal(test.a, test.b); eq != test.eq {
+			t.Errorf("Equal(%v, %v) = %v, want %v", test.a.Slice(), test.b.Slice(), eq, test.eq)
+		}
+	}
+
+	s := From("1", "2")
+	if !EqualFunc(From(1, 2), s, func(x int, y string) bool { return strconv.Itoa(x) == y }) {
+		t.Errorf("EqualFunc([1 2], [\"1\" \"2\"]) = false, want true")
+	}
+}
+
+// Test that versions of a list can be used by many goroutines concurrently.
+func TestConcurrentVersions(t *testing.T) {
+	base := From(1, 2, 3)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			l := base
+			for j := 0; j < 100; j++ {
+				l = l.Cons(i)
+			}
+			if l.Len() != 103 {
+				t.Errorf("l.Len() = %d, want 103", l.Len())
+			}
+		}(i)
+	}
+	wg.Wait()
+	checkList(t, base, []int{1, 2, 3})
+}
+
+func checkList[T comparable](t *testing.T, l *List[T], es []T) {
+	t.Helper()
+
+	if n := l.Len(); n != len(es) {
+		t.Errorf("l.Len() = %d, want %d", n, len(es))
+		return
+	}
+	if l.Empty() != (len(es) == 0) {
+		t.Errorf("l.Empty() = %v, want %v", l.Empty(), len(es) == 0)
+	}
+	if s := l.Slice(); !slices.Equal(s, es) {
+		t.Errorf("l = %v, want %v", s, es)
+	}
+}
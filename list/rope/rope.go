@@ -0,0 +1,364 @@
+// Package rope contains an implementation of a rope, a balanced binary tree of text chunks.
+//
+// Ropes are immutable: every operation returns a new rope sharing structure with the original one.
+// All offsets are byte offsets.
+package rope
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	// maxLeaf is the maximum length of a leaf chunk produced when building a rope.
+	maxLeaf = 512
+	// maxDepth is the maximum depth of a rope; deeper ropes are always rebalanced.
+	maxDepth = 64
+)
+
+// fib[i] is the (i+2)-th Fibonacci number.
+// A rope of depth d is balanced if its length is at least fib[d].
+var fib [maxDepth + 1]int
+
+func init() {
+	fib[0], fib[1] = 1, 2
+	for i := 2; i < len(fib); i++ {
+		fib[i] = fib[i-1] + fib[i-2]
+	}
+}
+
+// node represents a node of a rope.
+// A leaf node holds a chunk of text, an internal node holds the concatenation of its children.
+type node struct {
+	left, right *node
+	leaf        string
+	len         int
+	// number of newlines
+	lines int
+	depth int
+}
+
+func newLeaf(s string) *node {
+	return &node{leaf: s, len: len(s), lines: strings.Count(s, "\n")}
+}
+
+func (n *node) isLeaf() bool {
+	return n.left == nil && n.right == nil
+}
+
+// concat returns the concatenation of nodes a and b; either may be nil.
+func concat(a, b *node) *node {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	case a.isLeaf() && b.isLeaf() && a.len+b.len <= maxLeaf:
+		return newLeaf(a.leaf + b.leaf)
+	}
+	return &node{
+		left:  a,
+		right: b,
+		len:   a.len + b.len,
+		lines: a.lines + b.lines,
+		depth: max(a.depth, b.depth) + 1,
+	}
+}
+
+// build returns a balanced tree over the leaves.
+func build(leaves []*node) *node {
+	switch len(leaves) {
+	case 0:
+		return nil
+	case 1:
+		return leaves[0]
+	}
+	m := len(leaves) / 2
+	return concat(build(leaves[:m]), build(leaves[m:]))
+}
+
+// split returns the nodes holding the text [0, i) and [i, n.len) of node n.
+func split(n *node, i int) (*node, *node) {
+	switch {
+	case n == nil:
+		return nil, nil
+	case i <= 0:
+		return nil, n
+	case i >= n.len:
+		return n, nil
+	case n.isLeaf():
+		return newLeaf(n.leaf[:i]), newLeaf(n.leaf[i:])
+	case i < n.left.len:
+		l, r := split(n.left, i)
+		return l, concat(r, n.right)
+	default:
+		l, r := split(n.right, i-n.left.len)
+		return concat(n.left, l), r
+	}
+}
+
+// walk calls f sequentially for each leaf chunk of node n intersecting the range [i, j), trimmed to the range.
+// If f returns false, walk stops the iteration and returns false.
+func walk(n *node, i, j int, f func(s string) bool) bool {
+	if n == nil || i >= j || j <= 0 || i >= n.len {
+		return true
+	}
+	if n.isLeaf() {
+		return f(n.leaf[max(i, 0):min(j, n.len)])
+	}
+	return walk(n.left, i, j, f) && walk(n.right, i-n.left.len, j-n.left.len, f)
+}
+
+// leaves appends the leaves of node n to ls and returns the extended slice.
+func leaves(n *node, ls []*node) []*node {
+	if n == nil {
+		return ls
+	}
+	if n.isLeaf() {
+		if n.len > 0 {
+			ls = append(ls, n)
+		}
+		return ls
+	}
+	return leaves(n.right, leaves(n.left, ls))
+}
+
+// Rope represents an immutable rope.
+// Default value represents an empty rope and is ready to use.
+type Rope struct {
+	root *node
+}
+
+// New returns a rope containing the string s.
+func New(s string) *Rope {
+	ls := make([]*node, 0, (len(s)+maxLeaf-1)/maxLeaf)
+	for len(s) > 0 {
+		n := min(len(s), maxLeaf)
+		ls = append(ls, newLeaf(s[:n]))
+		s = s[n:]
+	}
+	return &Rope{build(ls)}
+}
+
+// newRope returns a rope with the root n, rebalancing it if necessary.
+func newRope(n *node) *Rope {
+	r := &Rope{n}
+	if !r.Balanced() {
+		return r.Rebalance()
+	}
+	return r
+}
+
+// Len returns the length of the rope in bytes.
+func (r *Rope) Len() int {
+	if r.root == nil {
+		return 0
+	}
+	return r.root.len
+}
+
+// Empty returns whether the rope is empty.
+func (r *Rope) Empty() bool {
+	return r.Len() == 0
+}
+
+// Depth returns the depth of the rope tree.
+func (r *Rope) Depth() int {
+	if r.root == nil {
+		return 0
+	}
+	return r.root.depth
+}
+
+// Balanced returns whether the rope is balanced.
+// A rope of depth d is balanced if its length is at least F(d+2), where F(n) is the n-th Fibonacci number.
+func (r *Rope) Balanced() bool {
+	if r.root == nil {
+		return true
+	}
+	d := r.Depth()
+	return d < maxDepth && r.Len() >= fib[d]
+}
+
+// Rebalance returns a balanced rope with the same content.
+func (r *Rope) Rebalance() *Rope {
+	return &Rope{build(leaves(r.root, nil))}
+}
+
+// Concat returns the concatenation of the ropes r and other.
+func (r *Rope) Concat(other *Rope) *Rope {
+	return newRope(concat(r.root, other.root))
+}
+
+// Split returns the ropes holding the text [0, i) and [i, r.Len()).
+func (r *Rope) Split(i int) (*Rope, *Rope, error) {
+	if i < 0 || i > r.Len() {
+		return nil, nil, fmt.Errorf("index i=%v out of bounds: [%v, %v]", i, 0, r.Len())
+	}
+	a, b := split(r.root, i)
+	return newRope(a), newRope(b), nil
+}
+
+// Insert returns a rope with the string s inserted at index i.
+func (r *Rope) Insert(i int, s string) (*Rope, error) {
+	if i < 0 || i > r.Len() {
+		return nil, fmt.Errorf("index i=%v out of bounds: [%v, %v]", i, 0, r.Len())
+	}
+	a, b := split(r.root, i)
+	return newRope(concat(concat(a, New(s).root), b)), nil
+}
+
+// Delete returns a rope with the text [i, j) removed.
+func (r *Rope) Delete(i, j int) (*Rope, error) {
+	if i < 0 || j > r.Len() || i > j {
+		return nil, fmt.Errorf("range [%v:%v] out of bounds: [%v, %v]", i, j, 0, r.Len())
+	}
+	a, rest := split(r.root, i)
+	_, b := split(rest, j-i)
+	return newRope(concat(a, b)), nil
+}
+
+// Index returns the byte at index i.
+func (r *Rope) Index(i int) (byte, error) {
+	if i < 0 || i >= r.Len() {
+		return 0, fmt.Errorf("index i=%v out of bounds: [%v, %v]", i, 0, r.Len()-1)
+	}
+	n := r.root
+	for !n.isLeaf() {
+		if i < n.left.len {
+			n = n.left
+		} else {
+			i -= n.left.len
+			n = n.right
+		}
+	}
+	return n.leaf[i], nil
+}
+
+// Substring returns the text [i, j).
+func (r *Rope) Substring(i, j int) (string, error) {
+	if i < 0 || j > r.Len() || i > j {
+		return "", fmt.Errorf("range [%v:%v] out of bounds: [%v, %v]", i, j, 0, r.Len())
+	}
+	var sb strings.Builder
+	sb.Grow(j - i)
+	walk(r.root, i, j, func(s string) bool {
+		sb.WriteString(s)
+		return true
+	})
+	return sb.String(), nil
+}
+
+// String returns the content of the rope.
+func (r *Rope) String() string {
+	s, _ := r.Substring(0, r.Len())
+	return s
+}
+
+// Lines returns the number of lines in the rope, which is the number of newlines plus one.
+func (r *Rope) Lines() int {
+	if r.root == nil {
+		return 1
+	}
+	return r.root.lines + 1
+}
+
+// Line returns the zero-based line number containing the byte at index i.
+func (r *Rope) Line(i int) (int, error) {
+	if i < 0 || i > r.Len() {
+		return 0, fmt.Errorf("index i=%v out of bounds: [%v, %v]", i, 0, r.Len())
+	}
+	line := 0
+	for n := r.root; n != nil; {
+		if n.isLeaf() {
+			line += strings.Count(n.leaf[:i], "\n")
+			break
+		}
+		if i < n.left.len {
+			n = n.left
+		} else {
+			i -= n.left.len
+			line += n.left.lines
+			n = n.right
+		}
+	}
+	return line, nil
+}
+
+// LineStart returns the index of the first byte of the zero-based line number line.
+func (r *Rope) LineStart(line int) (int, error) {
+	if line < 0 || line >= r.Lines() {
+		return 0, fmt.Errorf("line=%v out of bounds: [%v, %v]", line, 0, r.Lines()-1)
+	}
+	if line == 0 {
+		return 0, nil
+	}
+
+	// find the index of the line-th newline and return the next index
+	i := 0
+	for n := r.root; ; {
+		if n.isLeaf() {
+			for j := 0; ; j++ {
+				if n.leaf[j] == '\n' {
+					if line--; line == 0 {
+						return i + j + 1, nil
+					}
+				}
+			}
+		}
+		if line <= n.left.lines {
+			n = n.left
+		} else {
+			i += n.left.len
+			line -= n.left.lines
+			n = n.right
+		}
+	}
+}
+
+// WriteTo writes the content of the rope to w.
+// It implements the [io.WriterTo] interface.
+func (r *Rope) WriteTo(w io.Writer) (int64, error) {
+	return r.Reader().WriteTo(w)
+}
+
+// Reader returns a reader reading the content of the rope.
+func (r *Rope) Reader() *Reader {
+	return &Reader{r: r}
+}
+
+// Reader implements the [io.Reader] and [io.WriterTo] interfaces by reading from a rope.
+type Reader struct {
+	r *Rope
+	// current reading index
+	i int
+}
+
+// Read implements the [io.Reader] interface.
+func (rd *Reader) Read(b []byte) (int, error) {
+	if rd.i >= rd.r.Len() {
+		return 0, io.EOF
+	}
+	n := 0
+	walk(rd.r.root, rd.i, rd.i+len(b), func(s string) bool {
+		n += copy(b[n:], s)
+		return true
+	})
+	rd.i += n
+	return n, nil
+}
+
+// WriteTo implements the [io.WriterTo] interface.
+func (rd *Reader) WriteTo(w io.Writer) (int64, error) {
+	var n int64
+	var err error
+	walk(rd.r.root, rd.i, rd.r.Len(), func(s string) bool {
+		var m int
+		m, err = io.WriteString(w, s)
+		n += int64(m)
+		return err == nil
+	})
+	rd.i += int(n)
+	return n, err
+}
@@ -0,0 +1,158 @@
+package rope
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestZeroValue(t *testing.T) {
+	var r Rope
+	checkRope(t, &r, "")
+
+	r2, err := r.Insert(0, "abc")
+	if err != nil {
+		t.Fatalf("r.Insert(0) returned an error: %v", err)
+	}
+	checkRope(t, r2, "abc")
+	checkRope(t, &r, "")
+}
+
+func TestEdits(t *testing.T) {
+	r := New("hello world")
+
+	r1, _ := r.Insert(5, ",")
+	checkRope(t, r1, "hello, world")
+	r2, _ := r1.Delete(5, 6)
+	checkRope(t, r2, "hello world")
+	a, b, _ := r2.Split(6)
+	checkRope(t, a, "hello ")
+	checkRope(t, b, "world")
+	checkRope(t, b.Concat(a), "worldhello ")
+
+	// the original rope is unchanged
+	checkRope(t, r, "hello world")
+}
+
+func TestOutOfBounds(t *testing.T) {
+	r := New("abc")
+	if _, err := r.Insert(4, "x"); err == nil {
+		t.Errorf("r.Insert(4) should return an error")
+	}
+	if _, err := r.Delete(2, 1); err == nil {
+		t.Errorf("r.Delete(2, 1) should return an error")
+	}
+	if _, _, err := r.Split(-1); err == nil {
+		t.Errorf("r.Split(-1) should return an error")
+	}
+	if _, err := r.Index(3); err == nil {
+		t.Errorf("r.Index(3) should return an error")
+	}
+	if _, err := r.Substring(0, 4); err == nil {
+		t.Errorf("r.Substring(0, 4) should return an error")
+	}
+	if _, err := r.LineStart(1); err == nil {
+		t.Errorf("r.LineStart(1) should return an error")
+	}
+}
+
+func TestLines(t *testing.T) {
+	s := strings.Repeat("line\nanother line\n\n", 200)
+	r := New(s)
+	checkRope(t, r, s)
+
+	lines := strings.Split(s, "\n")
+	if n := r.Lines(); n != len(lines) {
+		t.Fatalf("r.Lines() = %d, want %d", n, len(lines))
+	}
+	start := 0
+	for i, l := range lines {
+		if got, err := r.LineStart(i); err != nil || got != start {
+			t.Errorf("r.LineStart(%d) = (%d, %v), want %d", i, got, err, start)
+		}
+		for j := start; j <= start+len(l); j++ {
+			if got, err := r.Line(j); err != nil || got != i {
+				t.Errorf("r.Line(%d) = (%d, %v), want %d", j, got, err, i)
+			}
+		}
+		start += len(l) + 1
+	}
+}
+
+func TestBalance(t *testing.T) {
+	var r Rope
+	rp := &r
+	for i := 0; i < 10000; i++ {
+		rp = rp.Concat(New("x"))
+	}
+	if !rp.Balanced() {
+		t.Errorf("rope of length %d and depth %d is not balanced", rp.Len(), rp.Depth())
+	}
+	checkRope(t, rp, strings.Repeat("x", 10000))
+}
+
+func TestReader(t *testing.T) {
+	s := strings.Repeat("0123456789", 1000)
+	r := New(s)
+
+	b, err := io.ReadAll(struct{ io.Reader }{r.Reader()}) // hide WriterTo
+	if err != nil || string(b) != s {
+		t.Errorf("io.ReadAll(r.Reader()) = (%d bytes, %v), want %d bytes", len(b), err, len(s))
+	}
+
+	var buf bytes.Buffer
+	if n, err := r.WriteTo(&buf); err != nil || n != int64(len(s)) || buf.String() != s {
+		t.Errorf("r.WriteTo() = (%d, %v), want %d bytes", n, err, len(s))
+	}
+}
+
+// Test the rope against a string under a random sequence of operations.
+func TestRandomized(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	r := New("")
+	model := ""
+
+	for i := 0; i < 2000; i++ {
+		if len(model) > 0 && rnd.Intn(3) == 0 {
+			k := rnd.Intn(len(model))
+			l := k + rnd.Intn(min(len(model)-k, 100)+1)
+			r, _ = r.Delete(k, l)
+			model = model[:k] + model[l:]
+		} else {
+			k := rnd.Intn(len(model) + 1)
+			s := strings.Repeat(string(rune('a'+rnd.Intn(26))), rnd.Intn(700))
+			r, _ = r.Insert(k, s)
+			model = model[:k] + s + model[k:]
+		}
+	}
+	checkRope(t, r, model)
+	if !r.Balanced() {
+		t.Errorf("rope of length %d and depth %d is not balanced", r.Len(), r.Depth())
+	}
+}
+
+func checkRope(t *testing.T, r *Rope, want string) {
+	t.Helper()
+
+	if n := r.Len(); n != len(want) {
+		t.Errorf("r.Len() = %d, want %d", n, len(want))
+		return
+	}
+	if s := r.String(); s != want {
+		t.Errorf("r.String() = %q, want %q", s, want)
+		return
+	}
+	for _, i := range []int{0, len(want) / 3, len(want) / 2, len(want) - 1} {
+		if i < 0 || i >= len(want) {
+			continue
+		}
+		if b, err := r.Index(i); err != nil || b != want[i] {
+			t.Errorf("r.Index(%d) = (%q, %v), want %q", i, b, err, want[i])
+		}
+		if s, err := r.Substring(i, len(want)); err != nil || s != want[i:] {
+			t.Errorf("r.Substring(%d, %d) = (%q, %v), want %q", i, len(want), s, err, want[i:])
+		}
+	}
+}
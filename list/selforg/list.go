@@ -0,0 +1,146 @@
+// Package selforg contains an implementation of a self-organizing list backed by a linked list.
+//
+// A self-organizing list reorders its elements on each successful search according to a [Policy],
+// so that frequently searched values migrate towards the front and are found faster.
+package selforg
+
+import (
+	"strconv"
+
+	"github.com/denpeshkov/datastructures/list/linked"
+)
+
+// Policy represents a strategy of reordering the list on a successful search.
+type Policy int
+
+const (
+	// MoveToFront moves the found element to the front of the list.
+	MoveToFront Policy = iota
+	// Transpose swaps the found element with its predecessor.
+	Transpose
+	// Count keeps the list ordered by the number of times each element was found,
+	// moving the found element in front of all the elements found less often.
+	Count
+)
+
+// String returns the name of the policy.
+func (p Policy) String() string {
+	switch p {
+	case MoveToFront:
+		return "MoveToFront"
+	case Transpose:
+		return "Transpose"
+	case Count:
+		return "Count"
+	}
+	return "Policy(" + strconv.Itoa(int(p)) + ")"
+}
+
+// Stats represents search statistics of the list.
+type Stats struct {
+	Searches uint64
+	Hits     uint64
+	// Comparisons is the total number of elements compared during searches
+	Comparisons uint64
+}
+
+// AverageDepth returns the average number of elements compared per search, or 0 if there were no searches.
+func (s Stats) AverageDepth() float64 {
+	if s.Searches == 0 {
+		return 0
+	}
+	return float64(s.Comparisons) / float64(s.Searches)
+}
+
+type item[T comparable] struct {
+	v     T
+	count int
+}
+
+// List represents a self-organizing list.
+type List[T comparable] struct {
+	l      *linked.Linked[item[T]]
+	policy Policy
+	stats  Stats
+}
+
+// New returns an initialized list reordered according to the policy.
+func New[T comparable](policy Policy) *List[T] {
+	return &List[T]{l: linked.New[item[T]](), policy: policy}
+}
+
+// Insert inserts value v at the back of the list.
+func (s *List[T]) Insert(v T) {
+	s.l.InsertBack(item[T]{v: v})
+}
+
+// Find returns whether value v is present in the list.
+// On success, the found element is reordered according to the policy of the list.
+func (s *List[T]) Find(v T) bool {
+	depth := 0
+	e, ok := linked.FindFunc(s.l, func(x item[T]) bool {
+		depth++
+		return x.v == v
+	})
+
+	s.stats.Searches++
+	s.stats.Comparisons += uint64(depth)
+	if !ok {
+		return false
+	}
+	s.stats.Hits++
+	s.reorder(e)
+	return true
+}
+
+// reorder reorders found element e according to the policy of the list.
+func (s *List[T]) reorder(e *linked.Element[item[T]]) {
+	switch s.policy {
+	case MoveToFront:
+		s.l.MoveToFront(e)
+	case Transpose:
+		if p := e.Prev(); p != nil {
+			s.l.MoveBefore(e, p)
+		}
+	case Count:
+		e.Value.count++
+		p := e.Prev()
+		for p != nil && p.Value.count < e.Value.count {
+			p = p.Prev()
+		}
+		if p == nil {
+			s.l.MoveToFront(e)
+		} else {
+			s.l.MoveAfter(e, p)
+		}
+	}
+}
+
+// Remove removes the first element with value v and returns whether it was present.
+// The search statistics are not updated.
+func (s *List[T]) Remove(v T) bool {
+	e, ok := linked.FindFunc(s.l, func(x item[T]) bool { return x.v == v })
+	if ok {
+		s.l.Remove(e)
+	}
+	return ok
+}
+
+// Values returns the values of the list from front to back.
+func (s *List[T]) Values() []T {
+	vs := make([]T, 0, s.l.Len())
+	for e := s.l.Front(); e != nil; e = e.Next() {
+		vs = append(vs, e.Value.v)
+	}
+	return vs
+}
+
+// Len returns the number of elements in the list.
+func (s *List[T]) Len() int {
+	return s.l.Len()
+}
+
+// Stats returns the search statistics of the list.
+func (s *List[T]) Stats() Stats {
+	return s.stats
+}
@@ -0,0 +1,118 @@
+package selforg
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func newList(p Policy, vs ...string) *List[string] {
+	s := New[string](p)
+	for _, v := range vs {
+		s.Insert(v)
+	}
+	return s
+}
+
+func TestMoveToFront(t *testing.T) {
+	s := newList(MoveToFront, "a", "b", "c", "d")
+	s.Find("c")
+	checkValues(t, s, []string{"c", "a", "b", "d"})
+	s.Find("d")
+	checkValues(t, s, []string{"d", "c", "a", "b"})
+	s.Find("d")
+	checkValues(t, s, []string{"d", "c", "a", "b"})
+}
+
+func TestTranspose(t *testing.T) {
+	s := newList(Transpose, "a", "b", "c", "d")
+	s.Find("c")
+	checkValues(t, s, []string{"a", "c", "b", "d"})
+	s.Find("c")
+	checkValues(t, s, []string{"c", "a", "b", "d"})
+	s.Find("c")
+	checkValues(t, s, []string{"c", "a", "b", "d"})
+}
+
+func TestCount(t *testing.T) {
+	s := newList(Count, "a", "b", "c", "d")
+	s.Find("c")
+	checkValues(t, s, []string{"c", "a", "b", "d"})
+	s.Find("d")
+	checkValues(t, s, []string{"c", "d", "a", "b"})
+	s.Find("d")
+	checkValues(t, s, []string{"d", "c", "a", "b"})
+	s.Find("a")
+	checkValues(t, s, []string{"d", "c", "a", "b"})
+}
+
+func TestStats(t *testing.T) {
+	s := newList(MoveToFront, "a", "b", "c")
+	s.Find("c") // 3 comparisons
+	s.Find("c") // 1 comparison
+	s.Find("x") // 3 comparisons
+
+	want := Stats{Searches: 3, Hits: 2, Comparisons: 7}
+	if got := s.Stats(); got != want {
+		t.Errorf("s.Stats() = %+v, want %+v", got, want)
+	}
+	if d := s.Stats().AverageDepth(); d != 7.0/3 {
+		t.Errorf("s.Stats().AverageDepth() = %v, want %v", d, 7.0/3)
+	}
+}
+
+func TestRemove(t *testing.T) {
+	s := newList(Count, "a", "b", "c")
+	if !s.Remove("b") || s.Remove("b") {
+		t.Errorf("s.Remove(b) should succeed once")
+	}
+	checkValues(t, s, []string{"a", "c"})
+	if s.Find("b") {
+		t.Errorf("s.Find(b) of a removed value = true, want false")
+	}
+}
+
+// Test that under a skewed access distribution every policy beats a static list in the worst order.
+func TestSkewedAccess(t *testing.T) {
+	const n = 50
+	vs := make([]int, n)
+	for i := range vs {
+		vs[i] = n - 1 - i // the most frequently searched values are at the back
+	}
+
+	trace := make([]int, 10000)
+	z := rand.NewZipf(rand.New(rand.NewSource(1)), 1.5, 1, n-1)
+	for i := range trace {
+		trace[i] = int(z.Uint64())
+	}
+
+	static := 0.0
+	for _, v := range trace {
+		static += float64(slices.Index(vs, v) + 1)
+	}
+	static /= float64(len(trace))
+
+	for _, p := range []Policy{MoveToFront, Transpose, Count} {
+		s := New[int](p)
+		for _, v := range vs {
+			s.Insert(v)
+		}
+		for _, v := range trace {
+			s.Find(v)
+		}
+		if d := s.Stats().AverageDepth(); d >= static/2 {
+			t.Errorf("%v: average depth = %v, want less than %v", p, d, static/2)
+		}
+	}
+}
+
+func checkValues(t *testing.T, s *List[string], want []string) {
+	t.Helper()
+
+	if n := s.Len(); n != len(want) {
+		t.Errorf("s.Len() = %d, want %d", n, len(want))
+	}
+	if got := s.Values(); !slices.Equal(got, want) {
+		t.Errorf("s.Values() = %v, want %v", got, want)
+	}
+}
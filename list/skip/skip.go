@@ -0,0 +1,309 @@
+// Package skip contains an implementation of an ordered skip list.
+package skip
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+const (
+	// maxLevel is the maximum number of levels in the list.
+	maxLevel = 32
+	// p is the inverse of the probability of promoting an element to the next level.
+	p = 4
+)
+
+// Element represents an element of the list.
+type Element[K, V any] struct {
+	Value V
+	key   K
+	prev  *Element[K, V]
+	next  []*Element[K, V]
+	// span[i] is the number of elements on the bottom level between this element and next[i], including next[i]
+	span []int
+}
+
+// Key returns the key of the element.
+func (e *Element[K, V]) Key() K {
+	return e.key
+}
+
+// Next returns the next element or nil.
+func (e *Element[K, V]) Next() *Element[K, V] {
+	if len(e.next) == 0 {
+		return nil
+	}
+	return e.next[0]
+}
+
+// Prev returns the previous element or nil.
+func (e *Element[K, V]) Prev() *Element[K, V] {
+	return e.prev
+}
+
+// List represents a skip list ordered by keys.
+// Search, insertion and deletion take O(log n) expected time.
+type List[K, V any] struct {
+	cmp func(a, b K) int
+	rnd *rand.Rand
+	// Sentinel node
+	// Front is head.next[0]
+	head  Element[K, V]
+	tail  *Element[K, V]
+	level int
+	len   int
+}
+
+// New returns an initialized list ordered by the cmp function.
+// The cmp function should return a negative number when a < b, a positive number when a > b and zero when a == b.
+func New[K, V any](cmp func(a, b K) int) *List[K, V] {
+	return NewSource[K, V](cmp, rand.NewSource(time.Now().UnixNano()))
+}
+
+// NewSource returns an initialized list ordered by the cmp function that uses src to choose element levels.
+// It is useful to obtain a deterministic structure of the list, e.g. in tests.
+func NewSource[K, V any](cmp func(a, b K) int, src rand.Source) *List[K, V] {
+	l := &List[K, V]{cmp: cmp, rnd: rand.New(src), level: 1}
+	l.head.next = make([]*Element[K, V], maxLevel)
+	l.head.span = make([]int, maxLevel)
+	return l
+}
+
+// randomLevel returns a random level for a new element.
+func (l *List[K, V]) randomLevel() int {
+	lvl := 1
+	for lvl < maxLevel && l.rnd.Intn(p) == 0 {
+		lvl++
+	}
+	return lvl
+}
+
+// search returns, for each level, the last element with key less than k.
+func (l *List[K, V]) search(k K, update *[maxLevel]*Element[K, V]) {
+	x := &l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && l.cmp(x.next[i].key, k) < 0 {
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+}
+
+// lowerBound returns the first element with key greater than or equal to k or nil.
+func (l *List[K, V]) lowerBound(k K) *Element[K, V] {
+	x := &l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && l.cmp(x.next[i].key, k) < 0 {
+			x = x.next[i]
+		}
+	}
+	return x.next[0]
+}
+
+// Front returns the element with the smallest key or nil if the list is empty.
+func (l *List[K, V]) Front() *Element[K, V] {
+	return l.head.next[0]
+}
+
+// Back returns the element with the largest key or nil if the list is empty.
+func (l *List[K, V]) Back() *Element[K, V] {
+	return l.tail
+}
+
+// Get returns the value associated with the key k.
+// The second parameter is true if the key is found; otherwise, it is false.
+func (l *List[K, V]) Get(k K) (V, bool) {
+	if e, ok := l.Find(k); ok {
+		return e.Value, true
+	}
+	return *new(V), false
+}
+
+// Find returns the element with the key k, or nil if not present.
+// The second parameter is true if the element is found; otherwise, it is false.
+func (l *List[K, V]) Find(k K) (*Element[K, V], bool) {
+	if e := l.lowerBound(k); e != nil && l.cmp(e.key, k) == 0 {
+		return e, true
+	}
+	return nil, false
+}
+
+// Set associates the value v with the key k and returns the element holding the pair.
+// If the key is already present, its value is replaced.
+func (l *List[K, V]) Set(k K, v V) *Element[K, V] {
+	var update [maxLevel]*Element[K, V]
+	// rank[i] is the number of elements preceding update[i]
+	var rank [maxLevel]int
+
+	x := &l.head
+	for i := l.level - 1; i >= 0; i-- {
+		if i < l.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.next[i] != nil && l.cmp(x.next[i].key, k) < 0 {
+			rank[i] += x.span[i]
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+
+	if e := x.next[0]; e != nil && l.cmp(e.key, k) == 0 {
+		e.Value = v
+		return e
+	}
+
+	lvl := l.randomLevel()
+	if lvl > l.level {
+		for i := l.level; i < lvl; i++ {
+			rank[i] = 0
+			update[i] = &l.head
+			update[i].span[i] = l.len
+		}
+		l.level = lvl
+	}
+
+	e := &Element[K, V]{
+		Value: v,
+		key:   k,
+		next:  make([]*Element[K, V], lvl),
+		span:  make([]int, lvl),
+	}
+	for i := 0; i < lvl; i++ {
+		e.next[i] = update[i].next[i]
+		update[i].next[i] = e
+
+		e.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+	// levels above the new element now span one more element
+	for i := lvl; i < l.level; i++ {
+		update[i].span[i]++
+	}
+
+	if update[0] != &l.head {
+		e.prev = update[0]
+	}
+	if e.next[0] != nil {
+		e.next[0].prev = e
+	} else {
+		l.tail = e
+	}
+
+	l.len++
+
+	return e
+}
+
+// Delete removes the key k and its value from the list.
+// It returns whether the key was present.
+func (l *List[K, V]) Delete(k K) bool {
+	var update [maxLevel]*Element[K, V]
+	l.search(k, &update)
+
+	e := update[0].next[0]
+	if e == nil || l.cmp(e.key, k) != 0 {
+		return false
+	}
+
+	for i := 0; i < l.level; i++ {
+		if update[i].next[i] == e {
+			update[i].span[i] += e.span[i] - 1
+			update[i].next[i] = e.next[i]
+		} else {
+			update[i].span[i]--
+		}
+	}
+	if e.next[0] != nil {
+		e.next[0].prev = e.prev
+	} else {
+		l.tail = e.prev
+	}
+	for l.level > 1 && l.head.next[l.level-1] == nil {
+		l.level--
+	}
+
+	e.prev = nil // avoid loitering
+	e.next = nil // avoid loitering
+	e.span = nil
+
+	l.len--
+
+	return true
+}
+
+// Floor returns the element with the largest key less than or equal to k, or nil if not present.
+// The second parameter is true if the element is found; otherwise, it is false.
+func (l *List[K, V]) Floor(k K) (*Element[K, V], bool) {
+	var update [maxLevel]*Element[K, V]
+	l.search(k, &update)
+
+	if e := update[0].next[0]; e != nil && l.cmp(e.key, k) == 0 {
+		return e, true
+	}
+	if update[0] == &l.head {
+		return nil, false
+	}
+	return update[0], true
+}
+
+// Ceiling returns the element with the smallest key greater than or equal to k, or nil if not present.
+// The second parameter is true if the element is found; otherwise, it is false.
+func (l *List[K, V]) Ceiling(k K) (*Element[K, V], bool) {
+	e := l.lowerBound(k)
+	return e, e != nil
+}
+
+// Range calls f sequentially for each key and value with the key in the range [lo, hi) in ascending order.
+// If f returns false, Range stops the iteration.
+func (l *List[K, V]) Range(lo, hi K, f func(k K, v V) bool) {
+	for e := l.lowerBound(lo); e != nil && l.cmp(e.key, hi) < 0; e = e.Next() {
+		if !f(e.key, e.Value) {
+			return
+		}
+	}
+}
+
+// Rank returns the number of keys in the list that are less than k.
+func (l *List[K, V]) Rank(k K) int {
+	r := 0
+	x := &l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && l.cmp(x.next[i].key, k) < 0 {
+			r += x.span[i]
+			x = x.next[i]
+		}
+	}
+	return r
+}
+
+// At returns an element at index ind in ascending order of keys.
+func (l *List[K, V]) At(ind int) (*Element[K, V], error) {
+	if ind < 0 || ind >= l.len {
+		return nil, fmt.Errorf("index ind=%v out of bounds: [%v, %v]", ind, 0, l.len-1)
+	}
+
+	// number of elements traversed so far
+	n := 0
+	x := &l.head
+	for i := l.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && n+x.span[i] <= ind+1 {
+			n += x.span[i]
+			x = x.next[i]
+		}
+		if n == ind+1 {
+			break
+		}
+	}
+	return x, nil
+}
+
+// Len returns the number of elements in the list.
+func (l *List[K, V]) Len() int {
+	return l.len
+}
+
+// Empty returns whether the list is empty.
+func (l *List[K, V]) Empty() bool {
+	return l.len == 0
+}
@@ -0,0 +1,203 @@
+package skip
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func newList() *List[int, string] {
+	return NewSource[int, string](cmp.Compare[int], rand.NewSource(1))
+}
+
+func TestSetGet(t *testing.T) {
+	l := newList()
+	if _, ok := l.Get(1); ok {
+		t.Errorf("l.Get(1) found a key in an empty list")
+	}
+
+	l.Set(2, "b")
+	l.Set(1, "a")
+	l.Set(3, "c")
+	l.Set(2, "bb")
+
+	checkList(t, l, []int{1, 2, 3})
+	tests := []struct {
+		k  int
+		v  string
+		ok bool
+	}{
+		{0, "", false},
+		{1, "a", true},
+		{2, "bb", true},
+		{3, "c", true},
+		{4, "", false},
+	}
+	for _, test := range tests {
+		if v, ok := l.Get(test.k); v != test.v || ok != test.ok {
+			t.Errorf("l.Get(%d) = (%q, %v), want (%q, %v)", test.k, v, ok, test.v, test.ok)
+		}
+	}
+}
+
+func TestDelete(t *testing.T) {
+	l := newList()
+	for _, k := range []int{5, 1, 4, 2, 3} {
+		l.Set(k, "")
+	}
+
+	if l.Delete(6) {
+		t.Errorf("l.Delete(6) = true, want false")
+	}
+	if !l.Delete(1) {
+		t.Errorf("l.Delete(1) = false, want true")
+	}
+	checkList(t, l, []int{2, 3, 4, 5})
+	l.Delete(5)
+	checkList(t, l, []int{2, 3, 4})
+	l.Delete(3)
+	checkList(t, l, []int{2, 4})
+	l.Delete(2)
+	l.Delete(4)
+	checkList(t, l, []int{})
+}
+
+func TestFloorCeiling(t *testing.T) {
+	l := newList()
+	for _, k := range []int{10, 20, 30} {
+		l.Set(k, "")
+	}
+
+	tests := []struct {
+		k       int
+		floor   int
+		floorOk bool
+		ceil    int
+		ceilOk  bool
+		rank    int
+	}{
+		{5, 0, false, 10, true, 0},
+		{10, 10, true, 10, true, 0},
+		{15, 10, true, 20, true, 1},
+		{30, 30, true, 30, true, 2},
+		{35, 30, true, 0, false, 3},
+	}
+	for _, test := range tests {
+		e, ok := l.Floor(test.k)
+		if ok != test.floorOk || ok && e.Key() != test.floor {
+			t.Errorf("l.Floor(%d) = (%v, %v), want (%d, %v)", test.k, e, ok, test.floor, test.floorOk)
+		}
+		e, ok = l.Ceiling(test.k)
+		if ok != test.ceilOk || ok && e.Key() != test.ceil {
+			t.Errorf("l.Ceiling(%d) = (%v, %v), want (%d, %v)", test.k, e, ok, test.ceil, test.ceilOk)
+		}
+		if r := l.Rank(test.k); r != test.rank {
+			t.Errorf("l.Rank(%d) = %d, want %d", test.k, r, test.rank)
+		}
+	}
+}
+
+func TestRange(t *testing.T) {
+	l := newList()
+	for k := 0; k < 10; k++ {
+		l.Set(k, "")
+	}
+
+	var got []int
+	l.Range(3, 7, func(k int, _ string) bool {
+		got = append(got, k)
+		return true
+	})
+	if want := []int{3, 4, 5, 6}; !slices.Equal(got, want) {
+		t.Errorf("l.Range(3, 7) = %v, want %v", got, want)
+	}
+
+	got = nil
+	l.Range(3, 7, func(k int, _ string) bool {
+		got = append(got, k)
+		return k < 4
+	})
+	if want := []int{3, 4}; !slices.Equal(got, want) {
+		t.Errorf("l.Range(3, 7) with early stop = %v, want %v", got, want)
+	}
+}
+
+func TestAt(t *testing.T) {
+	l := newList()
+	if _, err := l.At(0); err == nil {
+		t.Errorf("l.At(0) on empty list should return an error")
+	}
+	for k := 0; k < 100; k++ {
+		l.Set(2*k, "")
+	}
+	for i := 0; i < 100; i++ {
+		e, err := l.At(i)
+		if err != nil || e.Key() != 2*i {
+			t.Errorf("l.At(%d) = (%v, %v), want %d", i, e, err, 2*i)
+		}
+	}
+	if _, err := l.At(-1); err == nil {
+		t.Errorf("l.At(-1) should return an error")
+	}
+}
+
+// Test the list against a sorted slice under a random sequence of operations.
+func TestRandomized(t *testing.T) {
+	l := newList()
+	rnd := rand.New(rand.NewSource(2))
+	var model []int
+
+	for i := 0; i < 5000; i++ {
+		k := rnd.Intn(500)
+		pos, found := slices.BinarySearch(model, k)
+		if rnd.Intn(3) == 0 {
+			if ok := l.Delete(k); ok != found {
+				t.Fatalf("l.Delete(%d) = %v, want %v", k, ok, found)
+			}
+			if found {
+				model = slices.Delete(model, pos, pos+1)
+			}
+		} else {
+			l.Set(k, "")
+			if !found {
+				model = slices.Insert(model, pos, k)
+			}
+		}
+		if r := l.Rank(k); r != pos {
+			t.Fatalf("l.Rank(%d) = %d, want %d", k, r, pos)
+		}
+	}
+	checkList(t, l, model)
+	for i, k := range model {
+		if e, _ := l.At(i); e.Key() != k {
+			t.Fatalf("l.At(%d) = %d, want %d", i, e.Key(), k)
+		}
+	}
+}
+
+func checkList(t *testing.T, l *List[int, string], keys []int) {
+	t.Helper()
+
+	if n := l.Len(); n != len(keys) {
+		t.Errorf("l.Len() = %d, want %d", n, len(keys))
+		return
+	}
+
+	var got []int
+	for e := l.Front(); e != nil; e = e.Next() {
+		got = append(got, e.Key())
+	}
+	if !slices.Equal(got, keys) {
+		t.Errorf("keys = %v, want %v", got, keys)
+	}
+
+	got = got[:0]
+	for e := l.Back(); e != nil; e = e.Prev() {
+		got = append(got, e.Key())
+	}
+	slices.Reverse(got)
+	if !slices.Equal(got, keys) {
+		t.Errorf("keys in reverse = %v, want %v", got, keys)
+	}
+}
@@ -0,0 +1,265 @@
+// Package unrolled contains an implementation of an unrolled doubly-linked list.
+//
+// Each node of the list stores a small array of elements, which improves the locality of references
+// and reduces the cost of indexing compared to [github.com/denpeshkov/datastructures/list/linked].
+package unrolled
+
+import "fmt"
+
+// DefaultNodeCap is the default maximum number of elements stored in a node.
+const DefaultNodeCap = 64
+
+// node represents a node of the list holding up to cap(e) elements.
+type node[T any] struct {
+	e          []T
+	prev, next *node[T]
+}
+
+// List represents an unrolled doubly-linked list.
+// Default value represents an empty list with [DefaultNodeCap] node capacity and is ready to use.
+type List[T any] struct {
+	head, tail *node[T]
+	len        int
+	nodeCap    int
+}
+
+// New returns an initialized list with nodes holding up to nodeCap elements.
+// If nodeCap is less than 2, [DefaultNodeCap] is used.
+func New[T any](nodeCap int) *List[T] {
+	if nodeCap < 2 {
+		nodeCap = DefaultNodeCap
+	}
+	return &List[T]{nodeCap: nodeCap}
+}
+
+// capacity returns the maximum number of elements stored in a node.
+func (l *List[T]) capacity() int {
+	if l.nodeCap == 0 {
+		return DefaultNodeCap
+	}
+	return l.nodeCap
+}
+
+// newNode returns an empty node linked after p, or at the front if p is nil.
+func (l *List[T]) newNode(p *node[T]) *node[T] {
+	n := &node[T]{e: make([]T, 0, l.capacity())}
+	n.prev = p
+	if p != nil {
+		n.next = p.next
+		p.next = n
+	} else {
+		n.next = l.head
+		l.head = n
+	}
+	if n.next != nil {
+		n.next.prev = n
+	} else {
+		l.tail = n
+	}
+	return n
+}
+
+// removeNode unlinks node n from the list.
+func (l *List[T]) removeNode(n *node[T]) {
+	if n.prev != nil {
+		n.prev.next = n.next
+	} else {
+		l.head = n.next
+	}
+	if n.next != nil {
+		n.next.prev = n.prev
+	} else {
+		l.tail = n.prev
+	}
+	n.prev = nil // avoid loitering
+	n.next = nil // avoid loitering
+}
+
+// find returns the node containing the element at index ind and the index of the element within the node.
+// It walks from the end of the list closest to ind.
+func (l *List[T]) find(ind int) (*node[T], int) {
+	if ind < l.len/2 {
+		n := l.head
+		for ind >= len(n.e) {
+			ind -= len(n.e)
+			n = n.next
+		}
+		return n, ind
+	}
+
+	n, i := l.tail, l.len-len(l.tail.e)
+	for ind < i {
+		n = n.prev
+		i -= len(n.e)
+	}
+	return n, ind - i
+}
+
+// Insert inserts value v at index ind, shifting the subsequent elements.
+// ind must be in the range [0, l.Len()].
+func (l *List[T]) Insert(ind int, v T) error {
+	if ind < 0 || ind > l.len {
+		return fmt.Errorf("index ind=%v out of bounds: [%v, %v]", ind, 0, l.len)
+	}
+
+	var n *node[T]
+	var i int
+	switch {
+	case l.head == nil:
+		n, i = l.newNode(nil), 0
+	case ind == l.len:
+		n, i = l.tail, len(l.tail.e)
+	default:
+		n, i = l.find(ind)
+	}
+
+	// split a full node moving the upper half of its elements to a new node
+	if len(n.e) == cap(n.e) {
+		m := l.newNode(n)
+		half := len(n.e) / 2
+		m.e = append(m.e, n.e[half:]...)
+		clear(n.e[half:]) // avoid loitering
+		n.e = n.e[:half]
+		if i > half {
+			n, i = m, i-half
+		}
+	}
+
+	n.e = append(n.e, v)
+	copy(n.e[i+1:], n.e[i:])
+	n.e[i] = v
+
+	l.len++
+
+	return nil
+}
+
+// InsertBack inserts value v at the back of the list.
+func (l *List[T]) InsertBack(v T) {
+	_ = l.Insert(l.len, v)
+}
+
+// InsertFront inserts value v at the front of the list.
+func (l *List[T]) InsertFront(v T) {
+	_ = l.Insert(0, v)
+}
+
+// Remove removes and returns the element at index ind, shifting the subsequent elements.
+func (l *List[T]) Remove(ind int) (T, error) {
+	if ind < 0 || ind >= l.len {
+		return *new(T), fmt.Errorf("index ind=%v out of bounds: [%v, %v]", ind, 0, l.len-1)
+	}
+
+	n, i := l.find(ind)
+	v := n.e[i]
+	copy(n.e[i:], n.e[i+1:])
+	n.e[len(n.e)-1] = *new(T) // avoid loitering
+	n.e = n.e[:len(n.e)-1]
+
+	l.len--
+
+	l.merge(n)
+
+	return v, nil
+}
+
+// merge keeps node n at least half full by merging it with, or borrowing elements from, its successor.
+// The last node is merged into its predecessor if they fit into a single node.
+// Empty nodes are removed.
+func (l *List[T]) merge(n *node[T]) {
+	half := cap(n.e) / 2
+	if len(n.e) >= half {
+		return
+	}
+
+	m := n.next
+	switch {
+	case m == nil:
+		if p := n.prev; len(n.e) == 0 {
+			l.removeNode(n)
+		} else if p != nil && len(p.e)+len(n.e) <= cap(p.e) {
+			p.e = append(p.e, n.e...)
+			l.removeNode(n)
+		}
+	case len(n.e)+len(m.e) <= cap(n.e):
+		n.e = append(n.e, m.e...)
+		l.removeNode(m)
+	default:
+		k := half - len(n.e)
+		n.e = append(n.e, m.e[:k]...)
+		copy(m.e, m.e[k:])
+		clear(m.e[len(m.e)-k:]) // avoid loitering
+		m.e = m.e[:len(m.e)-k]
+	}
+}
+
+// At returns the element at index ind.
+func (l *List[T]) At(ind int) (T, error) {
+	if ind < 0 || ind >= l.len {
+		return *new(T), fmt.Errorf("index ind=%v out of bounds: [%v, %v]", ind, 0, l.len-1)
+	}
+	n, i := l.find(ind)
+	return n.e[i], nil
+}
+
+// Set replaces the element at index ind with value v.
+func (l *List[T]) Set(ind int, v T) error {
+	if ind < 0 || ind >= l.len {
+		return fmt.Errorf("index ind=%v out of bounds: [%v, %v]", ind, 0, l.len-1)
+	}
+	n, i := l.find(ind)
+	n.e[i] = v
+	return nil
+}
+
+// Len returns the number of elements in the list.
+func (l *List[T]) Len() int {
+	return l.len
+}
+
+// Empty returns whether the list is empty.
+func (l *List[T]) Empty() bool {
+	return l.len == 0
+}
+
+// Iterator is an iterator for an unrolled list.
+type Iterator[T any] struct {
+	n *node[T]
+	i int
+}
+
+// Next advances the iterator to the next element and returns whether there is one.
+// It must be called before the first call to [Iterator.Value].
+func (it *Iterator[T]) Next() bool {
+	if it.n == nil {
+		return false
+	}
+	it.i++
+	for it.n != nil && it.i >= len(it.n.e) {
+		it.n, it.i = it.n.next, 0
+	}
+	return it.n != nil
+}
+
+// Value returns the element the iterator is pointing at.
+func (it *Iterator[T]) Value() T {
+	return it.n.e[it.i]
+}
+
+// Iter returns an iterator positioned before the first element.
+// The list must not be modified while iterating.
+func (l *List[T]) Iter() Iterator[T] {
+	return Iterator[T]{n: l.head, i: -1}
+}
+
+// Do calls f sequentially for each element in the list from front to back.
+// If f returns false, Do stops the iteration.
+func (l *List[T]) Do(f func(v T) bool) {
+	for n := l.head; n != nil; n = n.next {
+		for _, v := range n.e {
+			if !f(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package unrolled
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/denpeshkov/datastructures/list/linked"
+)
+
+func TestZeroValue(t *testing.T) {
+	var l List[int]
+	l.InsertBack(2)
+	l.InsertFront(1)
+	l.InsertBack(3)
+	checkList(t, &l, []int{1, 2, 3})
+	if c := cap(l.head.e); c != DefaultNodeCap {
+		t.Errorf("node capacity = %d, want %d", c, DefaultNodeCap)
+	}
+}
+
+func TestInsertRemove(t *testing.T) {
+	l := New[int](4)
+	var model []int
+
+	for i := 0; i < 20; i++ {
+		l.InsertBack(i)
+		model = append(model, i)
+	}
+	checkList(t, l, model)
+
+	for _, ind := range []int{0, 5, 20, 11, 3} {
+		if err := l.Insert(ind, -ind); err != nil {
+			t.Fatalf("l.Insert(%d) returned an error: %v", ind, err)
+		}
+		model = slices.Insert(model, ind, -ind)
+		checkList(t, l, model)
+	}
+
+	for _, ind := range []int{0, 10, 22, 3, 3, 3} {
+		v, err := l.Remove(ind)
+		if err != nil {
+			t.Fatalf("l.Remove(%d) returned an error: %v", ind, err)
+		}
+		if v != model[ind] {
+			t.Errorf("l.Remove(%d) = %d, want %d", ind, v, model[ind])
+		}
+		model = slices.Delete(model, ind, ind+1)
+		checkList(t, l, model)
+	}
+
+	for !l.Empty() {
+		if _, err := l.Remove(l.Len() - 1); err != nil {
+			t.Fatalf("l.Remove(%d) returned an error: %v", l.Len()-1, err)
+		}
+	}
+	checkList(t, l, []int{})
+	if l.head != nil || l.tail != nil {
+		t.Errorf("empty list has nodes: head = %p, tail = %p", l.head, l.tail)
+	}
+}
+
+func TestOutOfBounds(t *testing.T) {
+	l := New[int](4)
+	l.InsertBack(1)
+
+	if err := l.Insert(-1, 0); err == nil {
+		t.Errorf("l.Insert(-1) should return an error")
+	}
+	if err := l.Insert(2, 0); err == nil {
+		t.Errorf("l.Insert(2) should return an error")
+	}
+	if _, err := l.At(1); err == nil {
+		t.Errorf("l.At(1) should return an error")
+	}
+	if err := l.Set(1, 0); err == nil {
+		t.Errorf("l.Set(1) should return an error")
+	}
+	if _, err := l.Remove(1); err == nil {
+		t.Errorf("l.Remove(1) should return an error")
+	}
+	checkList(t, l, []int{1})
+}
+
+func TestSet(t *testing.T) {
+	l := New[int](4)
+	for i := 0; i < 10; i++ {
+		l.InsertBack(i)
+	}
+	for i := 0; i < 10; i++ {
+		if err := l.Set(i, i*i); err != nil {
+			t.Fatalf("l.Set(%d) returned an error: %v", i, err)
+		}
+	}
+	checkList(t, l, []int{0, 1, 4, 9, 16, 25, 36, 49, 64, 81})
+}
+
+// Test the list against a slice under a random sequence of operations.
+func TestRandomized(t *testing.T) {
+	l := New[int](8)
+	rnd := rand.New(rand.NewSource(1))
+	var model []int
+
+	for i := 0; i < 5000; i++ {
+		if len(model) > 0 && rnd.Intn(2) == 0 {
+			ind := rnd.Intn(len(model))
+			if v, _ := l.Remove(ind); v != model[ind] {
+				t.Fatalf("l.Remove(%d) = %d, want %d", ind, v, model[ind])
+			}
+			model = slices.Delete(model, ind, ind+1)
+		} else {
+			ind := rnd.Intn(len(model) + 1)
+			_ = l.Insert(ind, i)
+			model = slices.Insert(model, ind, i)
+		}
+	}
+	checkList(t, l, model)
+}
+
+func checkList(t *testing.T, l *List[int], es []int) {
+	t.Helper()
+
+	if n := l.Len(); n != len(es) {
+		t.Errorf("l.Len() = %d, want %d", n, len(es))
+		return
+	}
+
+	var got []int
+	for it := l.Iter(); it.Next(); {
+		got = append(got, it.Value())
+	}
+	if !slices.Equal(got, es) {
+		t.Errorf("Iter() = %v, want %v", got, es)
+	}
+
+	got = got[:0]
+	l.Do(func(v int) bool {
+		got = append(got, v)
+		return true
+	})
+	if !slices.Equal(got, es) {
+		t.Errorf("Do() = %v, want %v", got, es)
+	}
+
+	for i, v := range es {
+		if e, err := l.At(i); err != nil || e != v {
+			t.Errorf("l.At(%d) = (%d, %v), want %d", i, e, err, v)
+		}
+	}
+
+	// check node links and fill
+	var prev *node[int]
+	for n := l.head; n != nil; n = n.next {
+		if n.prev != prev {
+			t.Errorf("node(%p).prev = %p, want %p", n, n.prev, prev)
+		}
+		if len(n.e) == 0 {
+			t.Errorf("node(%p) is empty", n)
+		}
+		prev = n
+	}
+	if l.tail != prev {
+		t.Errorf("l.tail = %p, want %p", l.tail, prev)
+	}
+}
+
+const benchLen = 1 << 14
+
+func BenchmarkScan(b *testing.B) {
+	b.Run("Unrolled", func(b *testing.B) {
+		l := New[int](DefaultNodeCap)
+		for i := 0; i < benchLen; i++ {
+			l.InsertBack(i)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			sum := 0
+			l.Do(func(v int) bool {
+				sum += v
+				return true
+			})
+		}
+	})
+	b.Run("Linked", func(b *testing.B) {
+		l := linked.New[int]()
+		for i := 0; i < benchLen; i++ {
+			l.InsertBack(i)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			sum := 0
+			for e := l.Front(); e != nil; e = e.Next() {
+				sum += e.Value
+			}
+		}
+	})
+}
+
+func BenchmarkAt(b *testing.B) {
+	b.Run("Unrolled", func(b *testing.B) {
+		l := New[int](DefaultNodeCap)
+		for i := 0; i < benchLen; i++ {
+			l.InsertBack(i)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = l.At(i % benchLen)
+		}
+	})
+	b.Run("Linked", func(b *testing.B) {
+		l := linked.New[int]()
+		for i := 0; i < benchLen; i++ {
+			l.InsertBack(i)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = l.At(i % benchLen)
+		}
+	})
+}
@@ -0,0 +1,2 @@
+// Package maps contains implementations of maps.
+package maps
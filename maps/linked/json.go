@@ -0,0 +1,132 @@
+package linked
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// MarshalJSON encodes the map as a JSON object with members in iteration order.
+// Keys are encoded like the keys of Go maps by [encoding/json]: keys must be strings, integers or implement [encoding.TextMarshaler].
+// It implements the [json.Marshaler] interface.
+func (m *Map[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	var err error
+	first := true
+	m.Do(func(k K, v V) bool {
+		var ks string
+		if ks, err = marshalKey(k); err != nil {
+			return false
+		}
+		var kb, vb []byte
+		if kb, err = json.Marshal(ks); err != nil {
+			return false
+		}
+		if vb, err = json.Marshal(v); err != nil {
+			return false
+		}
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		buf.Write(kb)
+		buf.WriteByte(':')
+		buf.Write(vb)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a JSON object into the map, preserving the order of its members.
+// Existing entries are kept; decoded keys that are already present have their values replaced.
+// It implements the [json.Unmarshaler] interface.
+func (m *Map[K, V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	t, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		return nil
+	}
+	if d, ok := t.(json.Delim); !ok || d != '{' {
+		return fmt.Errorf("cannot unmarshal %v into an ordered map: expected an object", t)
+	}
+
+	m.lazyInit()
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		k, err := unmarshalKey[K](t.(string))
+		if err != nil {
+			return err
+		}
+		var v V
+		if err := dec.Decode(&v); err != nil {
+			return err
+		}
+		m.Set(k, v)
+	}
+	_, err = dec.Token()
+	return err
+}
+
+// marshalKey returns the string representation of key k.
+func marshalKey[K comparable](k K) (string, error) {
+	if tm, ok := any(k).(encoding.TextMarshaler); ok {
+		b, err := tm.MarshalText()
+		return string(b), err
+	}
+	rv := reflect.ValueOf(k)
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	}
+	return "", fmt.Errorf("unsupported key type %T", k)
+}
+
+// unmarshalKey returns the key represented by string s.
+func unmarshalKey[K comparable](s string) (K, error) {
+	var k K
+	if tu, ok := any(&k).(encoding.TextUnmarshaler); ok {
+		err := tu.UnmarshalText([]byte(s))
+		return k, err
+	}
+	rv := reflect.ValueOf(&k).Elem()
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, rv.Type().Bits())
+		if err != nil {
+			return k, fmt.Errorf("invalid key %q: %w", s, err)
+		}
+		rv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, rv.Type().Bits())
+		if err != nil {
+			return k, fmt.Errorf("invalid key %q: %w", s, err)
+		}
+		rv.SetUint(n)
+	default:
+		return k, fmt.Errorf("unsupported key type %T", k)
+	}
+	return k, nil
+}
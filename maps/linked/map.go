@@ -0,0 +1,164 @@
+// Package linked contains an implementation of a hash map with predictable iteration order backed by a linked list.
+//
+// The map iterates its entries in insertion order, or optionally in access order from the least to the most recently accessed entry.
+package linked
+
+import (
+	list "github.com/denpeshkov/datastructures/list/linked"
+)
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// Map represents a hash map with predictable iteration order.
+// Default value represents an empty map iterated in insertion order and is ready to use.
+type Map[K comparable, V any] struct {
+	// Front is the oldest entry
+	l           *list.Linked[entry[K, V]]
+	m           map[K]*list.Element[entry[K, V]]
+	accessOrder bool
+}
+
+// New returns an initialized map iterated in insertion order.
+// Replacing the value of a key does not change its position.
+func New[K comparable, V any]() *Map[K, V] {
+	return &Map[K, V]{
+		l: list.New[entry[K, V]](),
+		m: make(map[K]*list.Element[entry[K, V]]),
+	}
+}
+
+// NewAccessOrder returns an initialized map iterated in access order, from the least to the most recently accessed entry.
+// Both [Map.Get] and [Map.Set] count as accesses.
+func NewAccessOrder[K comparable, V any]() *Map[K, V] {
+	m := New[K, V]()
+	m.accessOrder = true
+	return m
+}
+
+// lazyInit initializes a zero map value.
+func (m *Map[K, V]) lazyInit() {
+	if m.l == nil {
+		m.l = list.New[entry[K, V]]()
+		m.m = make(map[K]*list.Element[entry[K, V]])
+	}
+}
+
+// Get returns the value associated with the key.
+// In access order, the entry becomes the most recently accessed.
+// The second parameter is true if the key is found; otherwise, it is false.
+func (m *Map[K, V]) Get(key K) (V, bool) {
+	e, ok := m.m[key]
+	if !ok {
+		return *new(V), false
+	}
+	if m.accessOrder {
+		m.l.MoveToBack(e)
+	}
+	return e.Value.value, true
+}
+
+// Set associates the value with the key.
+// A new key is placed at the back of the iteration order.
+// In access order, an existing key is moved to the back as well.
+func (m *Map[K, V]) Set(key K, value V) {
+	m.lazyInit()
+	if e, ok := m.m[key]; ok {
+		e.Value.value = value
+		if m.accessOrder {
+			m.l.MoveToBack(e)
+		}
+		return
+	}
+	m.m[key] = m.l.InsertBack(entry[K, V]{key, value})
+}
+
+// Delete removes the key from the map and returns whether it was present.
+func (m *Map[K, V]) Delete(key K) bool {
+	e, ok := m.m[key]
+	if !ok {
+		return false
+	}
+	m.l.Remove(e)
+	delete(m.m, key)
+	return true
+}
+
+// MoveToBack moves the key to the back of the iteration order and returns whether it was present.
+func (m *Map[K, V]) MoveToBack(key K) bool {
+	e, ok := m.m[key]
+	if ok {
+		m.l.MoveToBack(e)
+	}
+	return ok
+}
+
+// MoveToFront moves the key to the front of the iteration order and returns whether it was present.
+func (m *Map[K, V]) MoveToFront(key K) bool {
+	e, ok := m.m[key]
+	if ok {
+		m.l.MoveToFront(e)
+	}
+	return ok
+}
+
+// Oldest returns the key and value at the front of the iteration order.
+// The third parameter is false if the map is empty.
+func (m *Map[K, V]) Oldest() (K, V, bool) {
+	if m.Len() == 0 {
+		return *new(K), *new(V), false
+	}
+	e := m.l.Front()
+	return e.Value.key, e.Value.value, true
+}
+
+// Newest returns the key and value at the back of the iteration order.
+// The third parameter is false if the map is empty.
+func (m *Map[K, V]) Newest() (K, V, bool) {
+	if m.Len() == 0 {
+		return *new(K), *new(V), false
+	}
+	e := m.l.Back()
+	return e.Value.key, e.Value.value, true
+}
+
+// Len returns the number of entries in the map.
+func (m *Map[K, V]) Len() int {
+	return len(m.m)
+}
+
+// Do calls f sequentially for each key and value in iteration order.
+// If f returns false, Do stops the iteration.
+// Iterating does not count as an access.
+func (m *Map[K, V]) Do(f func(key K, value V) bool) {
+	if m.l == nil {
+		return
+	}
+	for e := m.l.Front(); e != nil; e = e.Next() {
+		if !f(e.Value.key, e.Value.value) {
+			return
+		}
+	}
+}
+
+// Keys returns the keys of the map in iteration order.
+func (m *Map[K, V]) Keys() []K {
+	ks := make([]K, 0, m.Len())
+	m.Do(func(k K, _ V) bool {
+		ks = append(ks, k)
+		return true
+	})
+	return ks
+}
+
+// Values returns the values of the map in iteration order.
+func (m *Map[K, V]) Values() []V {
+	vs := make([]V, 0, m.Len())
+	m.Do(func(_ K, v V) bool {
+		vs = append(vs, v)
+		return true
+	})
+	return vs
+}
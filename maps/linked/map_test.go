@@ -0,0 +1,156 @@
+package linked
+
+import (
+	"encoding/json"
+	"net/netip"
+	"slices"
+	"testing"
+)
+
+func TestInsertionOrder(t *testing.T) {
+	var m Map[string, int]
+	m.Set("b", 1)
+	m.Set("a", 2)
+	m.Set("c", 3)
+	checkMap(t, &m, []string{"b", "a", "c"}, []int{1, 2, 3})
+
+	m.Set("b", 10) // replacing a value does not change the order
+	if v, ok := m.Get("a"); !ok || v != 2 {
+		t.Errorf("m.Get(a) = (%d, %v), want (2, true)", v, ok)
+	}
+	checkMap(t, &m, []string{"b", "a", "c"}, []int{10, 2, 3})
+
+	if !m.Delete("a") || m.Delete("a") {
+		t.Errorf("m.Delete(a) should succeed once")
+	}
+	m.Set("a", 4)
+	checkMap(t, &m, []string{"b", "c", "a"}, []int{10, 3, 4})
+}
+
+func TestAccessOrder(t *testing.T) {
+	m := NewAccessOrder[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+
+	m.Get("a")
+	checkMap(t, m, []string{"b", "c", "a"}, []int{2, 3, 1})
+	m.Set("b", 20)
+	checkMap(t, m, []string{"c", "a", "b"}, []int{3, 1, 20})
+	if _, ok := m.Get("x"); ok {
+		t.Errorf("m.Get(x) of an absent key = true, want false")
+	}
+	checkMap(t, m, []string{"c", "a", "b"}, []int{3, 1, 20})
+}
+
+func TestMove(t *testing.T) {
+	m := New[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+
+	if !m.MoveToBack("a") {
+		t.Errorf("m.MoveToBack(a) = false, want true")
+	}
+	checkMap(t, m, []string{"b", "c", "a"}, []int{2, 3, 1})
+	if !m.MoveToFront("c") {
+		t.Errorf("m.MoveToFront(c) = false, want true")
+	}
+	checkMap(t, m, []string{"c", "b", "a"}, []int{3, 2, 1})
+	if m.MoveToBack("x") {
+		t.Errorf("m.MoveToBack(x) of an absent key = true, want false")
+	}
+
+	if k, v, ok := m.Oldest(); !ok || k != "c" || v != 3 {
+		t.Errorf("m.Oldest() = (%s, %d, %v), want (c, 3, true)", k, v, ok)
+	}
+	if k, v, ok := m.Newest(); !ok || k != "a" || v != 1 {
+		t.Errorf("m.Newest() = (%s, %d, %v), want (a, 1, true)", k, v, ok)
+	}
+	if _, _, ok := New[string, int]().Oldest(); ok {
+		t.Errorf("Oldest() of an empty map = true, want false")
+	}
+}
+
+func TestJSON(t *testing.T) {
+	m := New[string, []int]()
+	m.Set("z", []int{1})
+	m.Set("a", nil)
+	m.Set("m", []int{2, 3})
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("json.Marshal() returned an error: %v", err)
+	}
+	if want := `{"z":[1],"a":null,"m":[2,3]}`; string(b) != want {
+		t.Errorf("json.Marshal() = %s, want %s", b, want)
+	}
+
+	var got Map[string, []int]
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("json.Unmarshal() returned an error: %v", err)
+	}
+	if ks := got.Keys(); !slices.Equal(ks, []string{"z", "a", "m"}) {
+		t.Errorf("keys after json.Unmarshal() = %v, want [z a m]", ks)
+	}
+
+	if err := json.Unmarshal([]byte(`[1, 2]`), &got); err == nil {
+		t.Errorf("json.Unmarshal() of an array should return an error")
+	}
+}
+
+func TestJSONKeys(t *testing.T) {
+	mi := New[int, string]()
+	mi.Set(3, "c")
+	mi.Set(-1, "a")
+	testJSONRoundTrip(t, mi, `{"3":"c","-1":"a"}`)
+
+	ma := New[netip.Addr, bool]()
+	ma.Set(netip.MustParseAddr("10.0.0.1"), true)
+	ma.Set(netip.MustParseAddr("::1"), false)
+	testJSONRoundTrip(t, ma, `{"10.0.0.1":true,"::1":false}`)
+
+	mf := New[float64, int]()
+	mf.Set(1.5, 1)
+	if _, err := json.Marshal(mf); err == nil {
+		t.Errorf("json.Marshal() with float keys should return an error")
+	}
+
+	var mu Map[uint8, int]
+	if err := json.Unmarshal([]byte(`{"300":1}`), &mu); err == nil {
+		t.Errorf("json.Unmarshal() with an out of range key should return an error")
+	}
+}
+
+func testJSONRoundTrip[K comparable, V comparable](t *testing.T, m *Map[K, V], want string) {
+	t.Helper()
+
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("json.Marshal() returned an error: %v", err)
+	}
+	if string(b) != want {
+		t.Errorf("json.Marshal() = %s, want %s", b, want)
+	}
+	got := New[K, V]()
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatalf("json.Unmarshal(%s) returned an error: %v", b, err)
+	}
+	if !slices.Equal(got.Keys(), m.Keys()) || !slices.Equal(got.Values(), m.Values()) {
+		t.Errorf("json round trip = %v: %v, want %v: %v", got.Keys(), got.Values(), m.Keys(), m.Values())
+	}
+}
+
+func checkMap(t *testing.T, m *Map[string, int], keys []string, values []int) {
+	t.Helper()
+
+	if n := m.Len(); n != len(keys) {
+		t.Errorf("m.Len() = %d, want %d", n, len(keys))
+	}
+	if ks := m.Keys(); !slices.Equal(ks, keys) {
+		t.Errorf("m.Keys() = %v, want %v", ks, keys)
+	}
+	if vs := m.Values(); !slices.Equal(vs, values) {
+		t.Errorf("m.Values() = %v, want %v", vs, values)
+	}
+}
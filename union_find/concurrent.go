@@ -0,0 +1,102 @@
+package unionfind
+
+import "sync/atomic"
+
+// Concurrent represents a union-find data structure that is safe for concurrent use by multiple goroutines.
+// It follows the randomized linking algorithm of Jayanti and Tarjan: parent pointers are updated only
+// with atomic compare-and-swap, so no goroutine ever blocks another.
+//
+// Roots are linked by a pseudo-random priority of their ids instead of their sizes,
+// and Find compacts paths with path splitting, which is safe to perform concurrently.
+// Find, Union and Connected are linearizable.
+type Concurrent struct {
+	p     []atomic.Int64
+	count atomic.Int64
+}
+
+// NewConcurrent returns a concurrent union-find with size elements, each in its own component.
+// It panics with a [*SizeError] if size is negative.
+func NewConcurrent(size int) *Concurrent {
+	if size < 0 {
+		panic(&SizeError{size})
+	}
+	uf := &Concurrent{p: make([]atomic.Int64, size)}
+	for i := range uf.p {
+		uf.p[i].Store(int64(i))
+	}
+	uf.count.Store(int64(size))
+	return uf
+}
+
+// priority returns the linking priority of element i.
+// It is a bijective mix of the id, so distinct elements have distinct priorities.
+func priority(i int) uint64 {
+	x := uint64(i)
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// Find returns the representative (root) element of the component containing element i.
+// The returned element was the root at some point during the call.
+func (uf *Concurrent) Find(i int) int {
+	p := uf.p
+	u := int64(i)
+	for {
+		v := p[u].Load()
+		w := p[v].Load()
+		if v == w {
+			return int(v)
+		}
+		// path splitting: make u point to its grandparent, unless another goroutine changed its parent
+		p[u].CompareAndSwap(v, w)
+		u = v
+	}
+}
+
+// Union merges the components containing elements p and q.
+// It returns false if p and q are already in the same component.
+func (uf *Concurrent) Union(p, q int) bool {
+	for {
+		u, v := uf.Find(p), uf.Find(q)
+		if u == v {
+			return false
+		}
+		if priority(u) > priority(v) {
+			u, v = v, u
+		}
+		// link the root of lower priority, failing if it stopped being a root
+		if uf.p[u].CompareAndSwap(int64(u), int64(v)) {
+			uf.count.Add(-1)
+			return true
+		}
+	}
+}
+
+// Connected returns whether elements p and q are in the same component.
+func (uf *Concurrent) Connected(p, q int) bool {
+	for {
+		u, v := uf.Find(p), uf.Find(q)
+		if u == v {
+			return true
+		}
+		// u and v were different roots at once only if u is still a root after finding v
+		if uf.p[u].Load() == int64(u) {
+			return false
+		}
+	}
+}
+
+// Count returns the number of components.
+// It is exact only when no unions are running concurrently.
+func (uf *Concurrent) Count() int {
+	return int(uf.count.Load())
+}
+
+// Len returns the number of elements.
+func (uf *Concurrent) Len() int {
+	return len(uf.p)
+}
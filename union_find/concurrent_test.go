@@ -0,0 +1,113 @@
+package unionfind
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestConcurrent(t *testing.T) {
+	uf := NewConcurrent(5)
+	if !uf.Union(0, 1) || !uf.Union(2, 3) || !uf.Union(1, 3) {
+		t.Fatalf("uf.Union() of disconnected elements = false, want true")
+	}
+	if uf.Union(0, 2) {
+		t.Errorf("uf.Union(0, 2) of connected elements = true, want false")
+	}
+	if !uf.Connected(0, 3) || uf.Connected(0, 4) {
+		t.Errorf("uf.Connected() reports wrong connectivity")
+	}
+	if r := uf.Find(4); r != 4 {
+		t.Errorf("uf.Find(4) = %d, want 4", r)
+	}
+	if c, n := uf.Count(), uf.Len(); c != 2 || n != 5 {
+		t.Errorf("uf.Count() = %d, uf.Len() = %d; want 2, 5", c, n)
+	}
+}
+
+// Test the concurrent union-find against a sequential one on the same unions performed by many goroutines.
+func TestConcurrentParallel(t *testing.T) {
+	const n = 2000
+	edges := randomEdges(n, n, 1)
+
+	uf := NewConcurrent(n)
+	var merged sync.WaitGroup
+	var unions [8]int
+	for g := range unions {
+		merged.Add(1)
+		go func(g int) {
+			defer merged.Done()
+			for i := g; i < len(edges); i += len(unions) {
+				if uf.Union(edges[i][0], edges[i][1]) {
+					unions[g]++
+				}
+				uf.Connected(edges[i][1], edges[(i+1)%len(edges)][0])
+			}
+		}(g)
+	}
+	merged.Wait()
+
+	ref := New(n)
+	for _, e := range edges {
+		ref.Union(e[0], e[1])
+	}
+	total := 0
+	for _, u := range unions {
+		total += u
+	}
+	if total != n-ref.Count() || uf.Count() != ref.Count() {
+		t.Errorf("%d successful unions and uf.Count() = %d, want %d and %d", total, uf.Count(), n-ref.Count(), ref.Count())
+	}
+	for i := 0; i < n; i++ {
+		if j := (i * 7) % n; uf.Connected(i, j) != ref.Connected(i, j) {
+			t.Fatalf("uf.Connected(%d, %d) = %v, want %v", i, j, uf.Connected(i, j), ref.Connected(i, j))
+		}
+	}
+}
+
+func randomEdges(n, m int, seed int64) [][2]int {
+	rnd := rand.New(rand.NewSource(seed))
+	edges := make([][2]int, m)
+	for i := range edges {
+		edges[i] = [2]int{rnd.Intn(n), rnd.Intn(n)}
+	}
+	return edges
+}
+
+// Benchmark computing the connected components of a random graph.
+func BenchmarkComponents(b *testing.B) {
+	const n = 1 << 20
+	edges := randomEdges(n, n, 1)
+
+	b.Run("Sequential", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			uf := New(n)
+			for _, e := range edges {
+				uf.Union(e[0], e[1])
+			}
+		}
+	})
+	procs := []int{1, 2, 4, runtime.GOMAXPROCS(0)}
+	slices.Sort(procs)
+	for _, procs := range slices.Compact(procs) {
+		b.Run(fmt.Sprintf("Concurrent/%d", procs), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				uf := NewConcurrent(n)
+				var wg sync.WaitGroup
+				for g := 0; g < procs; g++ {
+					wg.Add(1)
+					go func(g int) {
+						defer wg.Done()
+						for _, e := range edges[g*len(edges)/procs : (g+1)*len(edges)/procs] {
+							uf.Union(e[0], e[1])
+						}
+					}(g)
+				}
+				wg.Wait()
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package unionfind
+
+// Map represents a union-find data structure over arbitrary comparable keys.
+// Keys are mapped onto the elements of a [UnionFind] and are created lazily on first use in [Map.Union] or [Map.Add].
+// Other operations treat unknown keys as singleton components without creating them.
+// Default value represents an empty union-find and is ready to use.
+type Map[K comparable] struct {
+	uf   UnionFind
+	ids  map[K]int
+	keys []K
+}
+
+// NewMap returns an empty union-find over keys of type K.
+func NewMap[K comparable]() *Map[K] {
+	return &Map[K]{ids: make(map[K]int)}
+}
+
+// id returns the element of key k, creating it if necessary.
+func (m *Map[K]) id(k K) int {
+	if id, ok := m.ids[k]; ok {
+		return id
+	}
+	if m.ids == nil {
+		m.ids = make(map[K]int)
+	}
+	id := m.uf.Add()
+	m.ids[k] = id
+	m.keys = append(m.keys, k)
+	return id
+}
+
+// Add adds key k in its own component if it is not present yet.
+// It returns false if the key is already present.
+func (m *Map[K]) Add(k K) bool {
+	if m.Contains(k) {
+		return false
+	}
+	m.id(k)
+	return true
+}
+
+// Contains returns whether key k is present.
+func (m *Map[K]) Contains(k K) bool {
+	_, ok := m.ids[k]
+	return ok
+}
+
+// Union merges the components containing keys p and q, creating the keys if necessary.
+// It returns false if p and q are already in the same component.
+func (m *Map[K]) Union(p, q K) bool {
+	return m.uf.Union(m.id(p), m.id(q))
+}
+
+// Find returns the representative key of the component containing key k.
+// An unknown key is its own representative.
+func (m *Map[K]) Find(k K) K {
+	id, ok := m.ids[k]
+	if !ok {
+		return k
+	}
+	return m.keys[m.uf.Find(id)]
+}
+
+// Connected returns whether keys p and q are in the same component.
+// An unknown key is connected only to itself.
+func (m *Map[K]) Connected(p, q K) bool {
+	i, ok1 := m.ids[p]
+	j, ok2 := m.ids[q]
+	if !ok1 || !ok2 {
+		return p == q
+	}
+	return m.uf.Connected(i, j)
+}
+
+// Size returns the number of keys in the component containing key k.
+// The component of an unknown key has size 1.
+func (m *Map[K]) Size(k K) int {
+	id, ok := m.ids[k]
+	if !ok {
+		return 1
+	}
+	return m.uf.Size(id)
+}
+
+// Count returns the number of components of the present keys.
+func (m *Map[K]) Count() int {
+	return m.uf.Count()
+}
+
+// Len returns the number of present keys.
+func (m *Map[K]) Len() int {
+	return m.uf.Len()
+}
@@ -0,0 +1,62 @@
+package unionfind
+
+import (
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	var m Map[string] // zero value is ready to use
+
+	if !m.Union("alice", "bob") {
+		t.Errorf("m.Union(alice, bob) = false, want true")
+	}
+	m.Union("carol", "dave")
+	if !m.Add("eve") || m.Add("eve") {
+		t.Errorf("m.Add(eve) should succeed once")
+	}
+	if m.Union("bob", "alice") {
+		t.Errorf("m.Union(bob, alice) of connected keys = true, want false")
+	}
+	if n, c := m.Len(), m.Count(); n != 5 || c != 3 {
+		t.Errorf("m.Len() = %d, m.Count() = %d; want 5, 3", n, c)
+	}
+
+	m.Union("alice", "dave")
+	if n, c := m.Len(), m.Count(); n != 5 || c != 2 {
+		t.Errorf("m.Len() = %d, m.Count() = %d; want 5, 2", n, c)
+	}
+
+	root := m.Find("alice")
+	for _, k := range []string{"bob", "carol", "dave"} {
+		if r := m.Find(k); r != root {
+			t.Errorf("m.Find(%s) = %s, want %s", k, r, root)
+		}
+		if !m.Connected("alice", k) {
+			t.Errorf("m.Connected(alice, %s) = false, want true", k)
+		}
+	}
+	if s := m.Size("carol"); s != 4 {
+		t.Errorf("m.Size(carol) = %d, want 4", s)
+	}
+	if m.Connected("alice", "eve") {
+		t.Errorf("m.Connected(alice, eve) = true, want false")
+	}
+}
+
+func TestMapUnknownKeys(t *testing.T) {
+	m := NewMap[int]()
+	m.Union(1, 2)
+
+	if r := m.Find(7); r != 7 {
+		t.Errorf("m.Find(7) = %d, want 7", r)
+	}
+	if s := m.Size(7); s != 1 {
+		t.Errorf("m.Size(7) = %d, want 1", s)
+	}
+	if !m.Connected(7, 7) || m.Connected(7, 1) {
+		t.Errorf("unknown key 7 should be connected only to itself")
+	}
+	if m.Contains(7) || m.Len() != 2 {
+		t.Errorf("queries created unknown keys: m.Len() = %d", m.Len())
+	}
+}
@@ -0,0 +1,234 @@
+package unionfind
+
+import "fmt"
+
+// Rollback represents a union-find data structure whose unions can be undone.
+// It uses union by size without path compression, so Find takes O(log n) time,
+// and records every merge in a history stack to restore earlier states with [Rollback.Rollback].
+type Rollback struct {
+	s []item
+	// history of merged roots, each attached to its parent by a union
+	history []merge
+	// number of unions ever recorded, used to stamp history entries
+	unions uint64
+	count  int
+}
+
+type merge struct {
+	root int
+	// stamp identifies the union that recorded the entry
+	stamp uint64
+}
+
+// Marker represents a state of a [Rollback] union-find returned by [Rollback.Snapshot].
+// The zero value marks the state without any unions.
+type Marker struct {
+	n int
+	// stamp of the last history entry at the time of the snapshot
+	stamp uint64
+}
+
+// NewRollback returns a union-find with size elements, each in its own component.
+// It panics with a [*SizeError] if size is negative.
+func NewRollback(size int) *Rollback {
+	if size < 0 {
+		panic(&SizeError{size})
+	}
+	uf := &Rollback{s: make([]item, size), count: size}
+	for i := range uf.s {
+		uf.s[i] = item{p: i, size: 1}
+	}
+	return uf
+}
+
+// Find returns the representative (root) element of the component containing element i.
+func (uf *Rollback) Find(i int) int {
+	for uf.s[i].p != i {
+		i = uf.s[i].p
+	}
+	return i
+}
+
+// Union merges the components containing elements p and q.
+// It returns false if p and q are already in the same component, in which case nothing is recorded.
+func (uf *Rollback) Union(p, q int) bool {
+	s := uf.s
+	i, j := uf.Find(p), uf.Find(q)
+
+	if i == j {
+		return false
+	}
+
+	if s[i].size > s[j].size {
+		i, j = j, i
+	}
+	s[i].p = j
+	s[j].size += s[i].size
+	uf.unions++
+	uf.history = append(uf.history, merge{i, uf.unions})
+	uf.count--
+
+	return true
+}
+
+// Connected returns whether elements p and q are in the same component.
+func (uf *Rollback) Connected(p, q int) bool {
+	return uf.Find(p) == uf.Find(q)
+}
+
+// Count returns the number of components.
+func (uf *Rollback) Count() int {
+	return uf.count
+}
+
+// Size returns the number of elements in the component containing element p.
+func (uf *Rollback) Size(p int) int {
+	return uf.s[uf.Find(p)].size
+}
+
+// Len returns the number of elements.
+func (uf *Rollback) Len() int {
+	return len(uf.s)
+}
+
+// Snapshot returns a marker of the current state to be passed to [Rollback.Rollback].
+func (uf *Rollback) Snapshot() Marker {
+	m := Marker{n: len(uf.history)}
+	if m.n > 0 {
+		m.stamp = uf.history[m.n-1].stamp
+	}
+	return m
+}
+
+// Rollback undoes the unions performed after the snapshot marker was taken.
+// It takes O(k) time, where k is the number of undone unions.
+//
+// It panics if the marker is stale, i.e. the state was already rolled back past it,
+// since the marked state can no longer be restored.
+func (uf *Rollback) Rollback(m Marker) {
+	if m.n > len(uf.history) || m.n > 0 && uf.history[m.n-1].stamp != m.stamp {
+		panic("unionfind: rollback to a stale marker")
+	}
+	for len(uf.history) > m.n {
+		i := uf.history[len(uf.history)-1].root
+		uf.history = uf.history[:len(uf.history)-1]
+
+		j := uf.s[i].p
+		uf.s[j].size -= uf.s[i].size
+		uf.s[i].p = i
+		uf.count++
+	}
+}
+
+// OpKind represents a kind of operation of a dynamic connectivity problem.
+type OpKind int
+
+const (
+	// AddEdge adds an edge between two elements.
+	AddEdge OpKind = iota
+	// RemoveEdge removes a previously added edge between two elements.
+	RemoveEdge
+	// Query asks whether two elements are connected.
+	Query
+)
+
+// Op represents an operation of a dynamic connectivity problem on elements P and Q.
+type Op struct {
+	Kind OpKind
+	P, Q int
+}
+
+// DynamicConnectivity answers the queries of an offline dynamic connectivity problem
+// on n elements and returns the answers to the [Query] operations in order.
+//
+// Each edge is active during an interval of operations and is inserted into the O(log m) nodes
+// of a segment tree over the m operations covering this interval.
+// A depth-first traversal of the tree applies the edges of each node to a [Rollback] union-find
+// and undoes them on the way back, answering each query at its leaf.
+// The total time is O(m log m log n).
+//
+// Edges are undirected, and the same edge can be added several times.
+// It returns a [*SizeError] if n is negative, an [*IndexError] for elements out of bounds
+// and an error for removals of edges that are not present.
+func DynamicConnectivity(n int, ops []Op) ([]bool, error) {
+	if n < 0 {
+		return nil, &SizeError{n}
+	}
+
+	type edge struct{ p, q int }
+	m := len(ops)
+
+	// tree[v] holds the edges active during the whole interval of node v
+	tree := make([][]edge, 4*max(m, 1))
+	var insert func(v, lo, hi, from, to int, e edge)
+	insert = func(v, lo, hi, from, to int, e edge) {
+		if to <= lo || hi <= from {
+			return
+		}
+		if from <= lo && hi <= to {
+			tree[v] = append(tree[v], e)
+			return
+		}
+		mid := (lo + hi) / 2
+		insert(2*v, lo, mid, from, to, e)
+		insert(2*v+1, mid, hi, from, to, e)
+	}
+
+	// start times of the active copies of each edge
+	active := make(map[edge][]int)
+	nq := 0
+	for i, op := range ops {
+		if op.P < 0 || op.P >= n {
+			return nil, &IndexError{op.P, n}
+		}
+		if op.Q < 0 || op.Q >= n {
+			return nil, &IndexError{op.Q, n}
+		}
+		e := edge{min(op.P, op.Q), max(op.P, op.Q)}
+
+		switch op.Kind {
+		case AddEdge:
+			active[e] = append(active[e], i)
+		case RemoveEdge:
+			starts := active[e]
+			if len(starts) == 0 {
+				return nil, fmt.Errorf("unionfind: operation %v removes edge (%v, %v) that is not present", i, op.P, op.Q)
+			}
+			insert(1, 0, m, starts[len(starts)-1], i, e)
+			active[e] = starts[:len(starts)-1]
+		case Query:
+			nq++
+		default:
+			return nil, fmt.Errorf("unionfind: operation %v has unknown kind %v", i, op.Kind)
+		}
+	}
+	for e, starts := range active {
+		for _, start := range starts {
+			insert(1, 0, m, start, m, e)
+		}
+	}
+
+	uf := NewRollback(n)
+	answers := make([]bool, 0, nq)
+	var dfs func(v, lo, hi int)
+	dfs = func(v, lo, hi int) {
+		marker := uf.Snapshot()
+		for _, e := range tree[v] {
+			uf.Union(e.p, e.q)
+		}
+		if hi-lo == 1 {
+			if op := ops[lo]; op.Kind == Query {
+				answers = append(answers, uf.Connected(op.P, op.Q))
+			}
+		} else {
+			mid := (lo + hi) / 2
+			dfs(2*v, lo, mid)
+			dfs(2*v+1, mid, hi)
+		}
+		uf.Rollback(marker)
+	}
+	if m > 0 {
+		dfs(1, 0, m)
+	}
+	return answers, nil
+}
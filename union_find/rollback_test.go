@@ -0,0 +1,152 @@
+package unionfind
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestRollback(t *testing.T) {
+	uf := NewRollback(5)
+	uf.Union(0, 1)
+	marker := uf.Snapshot()
+
+	uf.Union(2, 3)
+	uf.Union(1, 3)
+	if uf.Union(0, 2) {
+		t.Errorf("uf.Union(0, 2) of connected elements = true, want false")
+	}
+	inner := uf.Snapshot()
+	uf.Union(3, 4)
+	if c, s := uf.Count(), uf.Size(0); c != 1 || s != 5 {
+		t.Errorf("uf.Count() = %d, uf.Size(0) = %d; want 1, 5", c, s)
+	}
+
+	uf.Rollback(inner)
+	if c, s := uf.Count(), uf.Size(0); c != 2 || s != 4 || uf.Connected(0, 4) {
+		t.Errorf("after inner rollback: uf.Count() = %d, uf.Size(0) = %d; want 2, 4", c, s)
+	}
+
+	uf.Rollback(marker)
+	if c := uf.Count(); c != 4 {
+		t.Errorf("uf.Count() = %d, want 4", c)
+	}
+	if !uf.Connected(0, 1) || uf.Connected(1, 2) || uf.Connected(2, 3) {
+		t.Errorf("unions after the marker were not undone")
+	}
+	for p, s := range []int{2, 2, 1, 1, 1} {
+		if got := uf.Size(p); got != s {
+			t.Errorf("uf.Size(%d) = %d, want %d", p, got, s)
+		}
+	}
+
+	// markers can be reused until rolled back past
+	uf.Union(2, 4)
+	uf.Rollback(marker)
+	if c := uf.Count(); c != 4 {
+		t.Errorf("uf.Count() after a repeated rollback = %d, want 4", c)
+	}
+
+	if !rollbackPanics(uf, inner) {
+		t.Errorf("uf.Rollback() to a marker beyond the history did not panic")
+	}
+	// the history grows back to the length of the stale marker with different unions
+	uf.Union(2, 3)
+	uf.Union(3, 4)
+	uf.Union(1, 4)
+	if !rollbackPanics(uf, inner) {
+		t.Errorf("uf.Rollback() to a stale marker did not panic")
+	}
+	if c := uf.Count(); c != 1 {
+		t.Errorf("uf.Count() after a rejected rollback = %d, want 1", c)
+	}
+
+	uf.Rollback(Marker{}) // the zero marker is the initial state
+	if c := uf.Count(); c != 5 {
+		t.Errorf("uf.Count() after rolling back to the zero marker = %d, want 5", c)
+	}
+}
+
+func rollbackPanics(uf *Rollback, m Marker) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	uf.Rollback(m)
+	return false
+}
+
+func TestDynamicConnectivity(t *testing.T) {
+	ops := []Op{
+		{Query, 0, 1},
+		{AddEdge, 0, 1},
+		{AddEdge, 1, 2},
+		{Query, 0, 2},
+		{AddEdge, 1, 0}, // a parallel edge
+		{RemoveEdge, 0, 1},
+		{Query, 2, 0},
+		{RemoveEdge, 1, 0},
+		{Query, 0, 2},
+		{Query, 1, 2},
+	}
+	got, err := DynamicConnectivity(3, ops)
+	if err != nil {
+		t.Fatalf("DynamicConnectivity() returned an error: %v", err)
+	}
+	if want := []bool{false, true, true, false, true}; !slices.Equal(got, want) {
+		t.Errorf("DynamicConnectivity() = %v, want %v", got, want)
+	}
+}
+
+func TestDynamicConnectivityErrors(t *testing.T) {
+	if _, err := DynamicConnectivity(2, []Op{{RemoveEdge, 0, 1}}); err == nil {
+		t.Errorf("removing an absent edge should return an error")
+	}
+	if _, err := DynamicConnectivity(2, []Op{{AddEdge, 0, 2}}); !isIndexError(err, 2, 2) {
+		t.Errorf("DynamicConnectivity() error = %v, want *IndexError with id 2", err)
+	}
+	if _, err := DynamicConnectivity(-1, nil); !isSizeError(err, -1) {
+		t.Errorf("DynamicConnectivity(-1) error = %v, want *SizeError", err)
+	}
+	if got, err := DynamicConnectivity(2, nil); err != nil || len(got) != 0 {
+		t.Errorf("DynamicConnectivity() without operations = (%v, %v), want no answers", got, err)
+	}
+}
+
+// Test the offline solver against recomputing the connectivity from scratch for each query.
+func TestDynamicConnectivityRandomized(t *testing.T) {
+	const n = 12
+	rnd := rand.New(rand.NewSource(1))
+
+	type edge struct{ p, q int }
+	var edges []edge
+	var ops []Op
+	var want []bool
+	for i := 0; i < 400; i++ {
+		switch k := rnd.Intn(3); {
+		case k == 0 || len(edges) == 0:
+			e := edge{rnd.Intn(n), rnd.Intn(n)}
+			edges = append(edges, e)
+			ops = append(ops, Op{AddEdge, e.p, e.q})
+		case k == 1:
+			j := rnd.Intn(len(edges))
+			ops = append(ops, Op{RemoveEdge, edges[j].q, edges[j].p})
+			edges = slices.Delete(edges, j, j+1)
+		default:
+			p, q := rnd.Intn(n), rnd.Intn(n)
+			ops = append(ops, Op{Query, p, q})
+			uf := New(n)
+			for _, e := range edges {
+				uf.Union(e.p, e.q)
+			}
+			want = append(want, uf.Connected(p, q))
+		}
+	}
+
+	got, err := DynamicConnectivity(n, ops)
+	if err != nil {
+		t.Fatalf("DynamicConnectivity() returned an error: %v", err)
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("DynamicConnectivity() = %v, want %v", got, want)
+	}
+}
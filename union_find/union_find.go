@@ -1,34 +1,111 @@
 // Package unionfind contains an implementation of a a disjoint-set (union-find) data structure.
 package unionfind
 
+import "fmt"
+
+// IndexError is returned when an element id is out of bounds.
+type IndexError struct {
+	ID int
+	// Len is the number of elements at the time of the error
+	Len int
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("unionfind: element id=%v out of bounds: [%v, %v)", e.ID, 0, e.Len)
+}
+
+// SizeError is returned when a number of elements is negative.
+type SizeError struct {
+	Size int
+}
+
+func (e *SizeError) Error() string {
+	return fmt.Sprintf("unionfind: negative size=%v", e.Size)
+}
+
 type item struct {
 	p    int
 	size int
+	// next element of the same component in a circular list, used to enumerate components
+	next int
 }
 
-// TODO
-// UnionFind represent an union-find data structure.
+// UnionFind represent an union-find data structure over elements numbered [0, Len()).
+// It uses union by size and path halving, so operations take amortized almost constant time.
+// Default value represents an empty union-find and is ready to use, see [UnionFind.Grow].
 type UnionFind struct {
 	s []item
+	// number of components
+	count int
 }
 
+// New returns a union-find with size elements, each in its own component.
+// It panics with a [*SizeError] if size is negative.
 func New(size int) *UnionFind {
+	uf, err := NewChecked(size)
+	if err != nil {
+		panic(err)
+	}
+	return uf
+}
+
+// NewChecked returns a union-find with size elements, each in its own component.
+// It returns a [*SizeError] if size is negative.
+func NewChecked(size int) (*UnionFind, error) {
+	if size < 0 {
+		return nil, &SizeError{size}
+	}
+
 	uf := &UnionFind{}
+	uf.s = make([]item, 0, size)
+	uf.grow(size)
+
+	return uf, nil
+}
 
-	uf.s = make([]item, size)
-	for i := 0; i < size; i++ {
-		uf.s[i] = item{i, 1}
+// grow appends n new elements, each in its own component.
+func (uf *UnionFind) grow(n int) {
+	for i := 0; i < n; i++ {
+		uf.s = append(uf.s, item{len(uf.s), 1, len(uf.s)})
 	}
+	uf.count += n
+}
 
-	return uf
+// Grow appends n new elements, each in its own component.
+// The new elements are numbered [Len(), Len()+n).
+// It returns a [*SizeError] if n is negative.
+func (uf *UnionFind) Grow(n int) error {
+	if n < 0 {
+		return &SizeError{n}
+	}
+	uf.grow(n)
+	return nil
 }
 
-func (uf *UnionFind) Union(p, q int) {
+// Add appends a new element in its own component and returns its id.
+func (uf *UnionFind) Add() int {
+	uf.grow(1)
+	return len(uf.s) - 1
+}
+
+// check returns an [*IndexError] for the first element id out of bounds.
+func (uf *UnionFind) check(ids ...int) error {
+	for _, id := range ids {
+		if id < 0 || id >= len(uf.s) {
+			return &IndexError{id, len(uf.s)}
+		}
+	}
+	return nil
+}
+
+// Union merges the components containing elements p and q.
+// It returns false if p and q are already in the same component.
+func (uf *UnionFind) Union(p, q int) bool {
 	s := uf.s
 	i, j := uf.Find(p), uf.Find(q)
 
 	if i == j {
-		return
+		return false
 	}
 
 	if s[i].size <= s[j].size {
@@ -38,16 +115,105 @@ func (uf *UnionFind) Union(p, q int) {
 		s[j].p = i
 		s[i].size += s[j].size
 	}
+	// splice the circular member lists of both components
+	s[i].next, s[j].next = s[j].next, s[i].next
+	uf.count--
+
+	return true
 }
 
+// Find returns the representative (root) element of the component containing element i.
 func (uf *UnionFind) Find(i int) int {
 	s := uf.s
 	for s[i].p != i {
+		// path halving: make every other node on the path point to its grandparent
+		s[i].p = s[s[i].p].p
 		i = s[i].p
 	}
 	return i
 }
 
+// Connected returns whether elements p and q are in the same component.
 func (uf *UnionFind) Connected(p, q int) bool {
 	return uf.Find(p) == uf.Find(q)
 }
+
+// Count returns the number of components.
+func (uf *UnionFind) Count() int {
+	return uf.count
+}
+
+// Size returns the number of elements in the component containing element p.
+func (uf *UnionFind) Size(p int) int {
+	return uf.s[uf.Find(p)].size
+}
+
+// Len returns the number of elements.
+func (uf *UnionFind) Len() int {
+	return len(uf.s)
+}
+
+// Members returns the elements of the component containing element p, starting with p.
+// It takes O(k) time, where k is the size of the component.
+func (uf *UnionFind) Members(p int) []int {
+	s := uf.s
+	ms := make([]int, 0, s[uf.Find(p)].size)
+	for i := p; ; {
+		ms = append(ms, i)
+		if i = s[i].next; i == p {
+			return ms
+		}
+	}
+}
+
+// Roots returns the representative (root) elements of all components in increasing order.
+func (uf *UnionFind) Roots() []int {
+	rs := make([]int, 0, uf.count)
+	for i, it := range uf.s {
+		if it.p == i {
+			rs = append(rs, i)
+		}
+	}
+	return rs
+}
+
+// Components returns the elements of all components, ordered as their representatives in [UnionFind.Roots].
+func (uf *UnionFind) Components() [][]int {
+	cs := make([][]int, 0, uf.count)
+	for _, r := range uf.Roots() {
+		cs = append(cs, uf.Members(r))
+	}
+	return cs
+}
+
+// UnionChecked is like [UnionFind.Union] but returns an [*IndexError] if p or q is out of bounds.
+func (uf *UnionFind) UnionChecked(p, q int) (bool, error) {
+	if err := uf.check(p, q); err != nil {
+		return false, err
+	}
+	return uf.Union(p, q), nil
+}
+
+// FindChecked is like [UnionFind.Find] but returns an [*IndexError] if i is out of bounds.
+func (uf *UnionFind) FindChecked(i int) (int, error) {
+	if err := uf.check(i); err != nil {
+		return 0, err
+	}
+	return uf.Find(i), nil
+}
+
+// ConnectedChecked is like [UnionFind.Connected] but returns an [*IndexError] if p or q is out of bounds.
+func (uf *UnionFind) ConnectedChecked(p, q int) (bool, error) {
+	if err := uf.check(p, q); err != nil {
+		return false, err
+	}
+	return uf.Connected(p, q), nil
+}
+
+// SizeChecked is like [UnionFind.Size] but returns an [*IndexError] if p is out of bounds.
+func (uf *UnionFind) SizeChecked(p int) (int, error) {
+	if err := uf.check(p); err != nil {
+		return 0, err
+	}
+	return uf.Size(p), nil
+}
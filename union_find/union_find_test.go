@@ -0,0 +1,274 @@
+package unionfind
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestUnion(t *testing.T) {
+	uf := New(6)
+	checkCount(t, uf, 6)
+
+	if !uf.Union(0, 1) {
+		t.Errorf("uf.Union(0, 1) = false, want true")
+	}
+	if !uf.Union(2, 3) {
+		t.Errorf("uf.Union(2, 3) = false, want true")
+	}
+	if !uf.Union(1, 3) {
+		t.Errorf("uf.Union(1, 3) = false, want true")
+	}
+	if uf.Union(0, 2) {
+		t.Errorf("uf.Union(0, 2) of connected elements = true, want false")
+	}
+	checkCount(t, uf, 3)
+
+	tests := []struct {
+		p, q      int
+		connected bool
+	}{
+		{0, 3, true},
+		{1, 2, true},
+		{0, 4, false},
+		{4, 5, false},
+		{5, 5, true},
+	}
+	for _, test := range tests {
+		if c := uf.Connected(test.p, test.q); c != test.connected {
+			t.Errorf("uf.Connected(%d, %d) = %v, want %v", test.p, test.q, c, test.connected)
+		}
+	}
+
+	for p, size := range []int{4, 4, 4, 4, 1, 1} {
+		if s := uf.Size(p); s != size {
+			t.Errorf("uf.Size(%d) = %d, want %d", p, s, size)
+		}
+	}
+	if n := uf.Len(); n != 6 {
+		t.Errorf("uf.Len() = %d, want 6", n)
+	}
+}
+
+func TestPathCompression(t *testing.T) {
+	const n = 1 << 10
+	uf := New(n)
+	// build a binomial tree of depth log n by merging the roots of equal-size trees,
+	// so that no Find walks a path that could be halved
+	roots := make([]int, n)
+	for i := range roots {
+		roots[i] = i
+	}
+	for len(roots) > 1 {
+		next := roots[:0]
+		for i := 0; i < len(roots); i += 2 {
+			uf.Union(roots[i], roots[i+1])
+			next = append(next, uf.Find(roots[i]))
+		}
+		roots = next
+	}
+	root := roots[0]
+
+	maxDepth := 0
+	for i := 0; i < n; i++ {
+		maxDepth = max(maxDepth, depth(uf, i))
+	}
+	if maxDepth <= 2 {
+		t.Fatalf("depth before Find = %d, want greater than 2", maxDepth)
+	}
+
+	for i := 0; i < n; i++ {
+		uf.Find(i)
+	}
+	for i := 0; i < n; i++ {
+		if d := depth(uf, i); d > 2 {
+			t.Fatalf("depth of %d after Find = %d, want at most 2", i, d)
+		}
+		if r := uf.Find(i); r != root {
+			t.Fatalf("uf.Find(%d) = %d, want %d", i, r, root)
+		}
+	}
+}
+
+// Test the union-find against a naive labeling under random unions.
+func TestRandomized(t *testing.T) {
+	const n = 200
+	uf := New(n)
+	label := make([]int, n)
+	for i := range label {
+		label[i] = i
+	}
+	count := n
+	rnd := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 500; i++ {
+		p, q := rnd.Intn(n), rnd.Intn(n)
+		merged := label[p] != label[q]
+		if merged {
+			old := label[q]
+			for j := range label {
+				if label[j] == old {
+					label[j] = label[p]
+				}
+			}
+			count--
+		}
+		if got := uf.Union(p, q); got != merged {
+			t.Fatalf("uf.Union(%d, %d) = %v, want %v", p, q, got, merged)
+		}
+	}
+	checkCount(t, uf, count)
+	for p := 0; p < n; p++ {
+		size := 0
+		for q := 0; q < n; q++ {
+			if label[q] == label[p] {
+				size++
+			}
+			if c := uf.Connected(p, q); c != (label[p] == label[q]) {
+				t.Fatalf("uf.Connected(%d, %d) = %v, want %v", p, q, c, !c)
+			}
+		}
+		if s := uf.Size(p); s != size {
+			t.Fatalf("uf.Size(%d) = %d, want %d", p, s, size)
+		}
+		ms := uf.Members(p)
+		if len(ms) != size || ms[0] != p {
+			t.Fatalf("uf.Members(%d) = %v, want %d members starting with %d", p, ms, size, p)
+		}
+		for _, q := range ms {
+			if label[q] != label[p] {
+				t.Fatalf("uf.Members(%d) contains %d from another component", p, q)
+			}
+		}
+	}
+	if rs := uf.Roots(); len(rs) != count {
+		t.Errorf("len(uf.Roots()) = %d, want %d", len(rs), count)
+	}
+}
+
+func TestComponents(t *testing.T) {
+	uf := New(6)
+	uf.Union(0, 3)
+	uf.Union(4, 5)
+	uf.Union(3, 5)
+
+	if ms := uf.Members(1); !slices.Equal(ms, []int{1}) {
+		t.Errorf("uf.Members(1) = %v, want [1]", ms)
+	}
+	ms := uf.Members(3)
+	slices.Sort(ms)
+	if !slices.Equal(ms, []int{0, 3, 4, 5}) {
+		t.Errorf("uf.Members(3) = %v, want [0 3 4 5]", ms)
+	}
+
+	roots := uf.Roots()
+	want := []int{1, 2, uf.Find(0)}
+	slices.Sort(want)
+	if !slices.Equal(roots, want) {
+		t.Errorf("uf.Roots() = %v, want %v", roots, want)
+	}
+
+	cs := uf.Components()
+	if len(cs) != len(roots) {
+		t.Fatalf("len(uf.Components()) = %d, want %d", len(cs), len(roots))
+	}
+	for i, c := range cs {
+		if c[0] != roots[i] || len(c) != uf.Size(roots[i]) {
+			t.Errorf("uf.Components()[%d] = %v, want the component of root %d", i, c, roots[i])
+		}
+	}
+
+	var empty UnionFind
+	if rs, cs := empty.Roots(), empty.Components(); len(rs) != 0 || len(cs) != 0 {
+		t.Errorf("empty union-find has roots %v and components %v", rs, cs)
+	}
+}
+
+func depth(uf *UnionFind, i int) int {
+	d := 0
+	for uf.s[i].p != i {
+		i = uf.s[i].p
+		d++
+	}
+	return d
+}
+
+func checkCount(t *testing.T, uf *UnionFind, count int) {
+	t.Helper()
+
+	if c := uf.Count(); c != count {
+		t.Errorf("uf.Count() = %d, want %d", c, count)
+	}
+}
+
+func TestChecked(t *testing.T) {
+	if _, err := NewChecked(-1); !isSizeError(err, -1) {
+		t.Errorf("NewChecked(-1) error = %v, want *SizeError with size -1", err)
+	}
+	uf, err := NewChecked(3)
+	if err != nil {
+		t.Fatalf("NewChecked(3) returned an error: %v", err)
+	}
+
+	if ok, err := uf.UnionChecked(0, 1); err != nil || !ok {
+		t.Errorf("uf.UnionChecked(0, 1) = (%v, %v), want (true, nil)", ok, err)
+	}
+	if _, err := uf.UnionChecked(0, 3); !isIndexError(err, 3, 3) {
+		t.Errorf("uf.UnionChecked(0, 3) error = %v, want *IndexError with id 3", err)
+	}
+	if _, err := uf.FindChecked(-1); !isIndexError(err, -1, 3) {
+		t.Errorf("uf.FindChecked(-1) error = %v, want *IndexError with id -1", err)
+	}
+	if ok, err := uf.ConnectedChecked(1, 0); err != nil || !ok {
+		t.Errorf("uf.ConnectedChecked(1, 0) = (%v, %v), want (true, nil)", ok, err)
+	}
+	if _, err := uf.ConnectedChecked(5, 0); !isIndexError(err, 5, 3) {
+		t.Errorf("uf.ConnectedChecked(5, 0) error = %v, want *IndexError with id 5", err)
+	}
+	if s, err := uf.SizeChecked(2); err != nil || s != 1 {
+		t.Errorf("uf.SizeChecked(2) = (%d, %v), want (1, nil)", s, err)
+	}
+	checkCount(t, uf, 2)
+}
+
+func TestNewNegativePanics(t *testing.T) {
+	defer func() {
+		if err, _ := recover().(error); !isSizeError(err, -2) {
+			t.Errorf("New(-2) panicked with %v, want *SizeError", err)
+		}
+	}()
+	New(-2)
+}
+
+func TestGrow(t *testing.T) {
+	var uf UnionFind // zero value is an empty union-find
+	if err := uf.Grow(2); err != nil {
+		t.Fatalf("uf.Grow(2) returned an error: %v", err)
+	}
+	if id := uf.Add(); id != 2 {
+		t.Errorf("uf.Add() = %d, want 2", id)
+	}
+	if err := uf.Grow(-1); !isSizeError(err, -1) {
+		t.Errorf("uf.Grow(-1) error = %v, want *SizeError", err)
+	}
+	checkCount(t, &uf, 3)
+
+	uf.Union(0, 2)
+	id := uf.Add()
+	uf.Union(id, 2)
+	if n, s := uf.Len(), uf.Size(0); n != 4 || s != 3 {
+		t.Errorf("uf.Len() = %d, uf.Size(0) = %d; want 4, 3", n, s)
+	}
+	checkCount(t, &uf, 2)
+}
+
+func isIndexError(err error, id, n int) bool {
+	var ie *IndexError
+	return errors.As(err, &ie) && ie.ID == id && ie.Len == n
+}
+
+func isSizeError(err error, size int) bool {
+	var se *SizeError
+	return errors.As(err, &se) && se.Size == size
+}
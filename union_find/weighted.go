@@ -0,0 +1,157 @@
+package unionfind
+
+import "fmt"
+
+// Group represents an abelian group over values of type T used as potentials by [Weighted].
+type Group[T any] interface {
+	// Identity returns the identity element.
+	Identity() T
+	// Op returns the group operation applied to a and b.
+	Op(a, b T) T
+	// Inverse returns the inverse of a.
+	Inverse(a T) T
+}
+
+// Integer is a constraint permitting any integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Sum is the group of integers under addition, wrapping around on overflow.
+// Floating-point numbers are not permitted, since rounding makes their addition inexact
+// and consistent relations would be reported as conflicts.
+type Sum[T Integer] struct{}
+
+func (Sum[T]) Identity() T   { return 0 }
+func (Sum[T]) Op(a, b T) T   { return a + b }
+func (Sum[T]) Inverse(a T) T { return -a }
+
+// Xor is the group of booleans under exclusive or, i.e. parity.
+type Xor struct{}
+
+func (Xor) Identity() bool      { return false }
+func (Xor) Op(a, b bool) bool   { return a != b }
+func (Xor) Inverse(a bool) bool { return a }
+
+// ConflictError is returned by [Weighted.Union] when a relation contradicts the already known ones.
+type ConflictError[T any] struct {
+	P, Q int
+	// Diff is the rejected relation, Want is the one implied by the previous unions.
+	Diff, Want T
+}
+
+func (e *ConflictError[T]) Error() string {
+	return fmt.Sprintf("unionfind: relation %v between elements %v and %v conflicts with %v", e.Diff, e.P, e.Q, e.Want)
+}
+
+type weightedItem[T any] struct {
+	p, size int
+	// potential relative to the parent
+	w T
+}
+
+// Weighted represents a union-find data structure in which every element stores its potential
+// relative to its parent, so that the relation between any two connected elements is known.
+// Potentials are values of an abelian group G, such as integers under addition or parity under exclusive or.
+// It uses union by size and path halving.
+type Weighted[T comparable, G Group[T]] struct {
+	s     []weightedItem[T]
+	g     G
+	count int
+}
+
+// NewWeighted returns a weighted union-find with size elements, each in its own component, over the group g.
+// It panics with a [*SizeError] if size is negative.
+func NewWeighted[T comparable, G Group[T]](size int, g G) *Weighted[T, G] {
+	if size < 0 {
+		panic(&SizeError{size})
+	}
+	uf := &Weighted[T, G]{s: make([]weightedItem[T], size), g: g, count: size}
+	for i := range uf.s {
+		uf.s[i] = weightedItem[T]{i, 1, g.Identity()}
+	}
+	return uf
+}
+
+// find returns the root of element i and the potential of i relative to it.
+func (uf *Weighted[T, G]) find(i int) (int, T) {
+	s, g := uf.s, uf.g
+	w := g.Identity()
+	for s[i].p != i {
+		// path halving, accumulating the potential of the skipped parent
+		p := s[i].p
+		s[i].w = g.Op(s[i].w, s[p].w)
+		s[i].p = s[p].p
+		w = g.Op(w, s[i].w)
+		i = s[i].p
+	}
+	return i, w
+}
+
+// Find returns the representative (root) element of the component containing element i.
+func (uf *Weighted[T, G]) Find(i int) int {
+	r, _ := uf.find(i)
+	return r
+}
+
+// Union merges the components containing elements p and q, recording that
+// the potential of q is the potential of p combined with diff, i.e. q - p = diff for integers under addition.
+// If p and q are already connected, it returns a [*ConflictError] when diff is inconsistent with their relation.
+func (uf *Weighted[T, G]) Union(p, q int, diff T) error {
+	s, g := uf.s, uf.g
+	i, wp := uf.find(p)
+	j, wq := uf.find(q)
+
+	if i == j {
+		if want := g.Op(wq, g.Inverse(wp)); want != diff {
+			return &ConflictError[T]{P: p, Q: q, Diff: diff, Want: want}
+		}
+		return nil
+	}
+
+	// potential of root j relative to root i
+	w := g.Op(g.Op(wp, diff), g.Inverse(wq))
+	if s[i].size < s[j].size {
+		i, j = j, i
+		w = g.Inverse(w)
+	}
+	s[j].p = i
+	s[j].w = w
+	s[i].size += s[j].size
+	uf.count--
+
+	return nil
+}
+
+// Diff returns the relation between elements p and q, i.e. the potential of q relative to p,
+// and whether they are connected.
+func (uf *Weighted[T, G]) Diff(p, q int) (T, bool) {
+	i, wp := uf.find(p)
+	j, wq := uf.find(q)
+	if i != j {
+		var zero T
+		return zero, false
+	}
+	return uf.g.Op(wq, uf.g.Inverse(wp)), true
+}
+
+// Connected returns whether elements p and q are in the same component.
+func (uf *Weighted[T, G]) Connected(p, q int) bool {
+	return uf.Find(p) == uf.Find(q)
+}
+
+// Count returns the number of components.
+func (uf *Weighted[T, G]) Count() int {
+	return uf.count
+}
+
+// Size returns the number of elements in the component containing element p.
+func (uf *Weighted[T, G]) Size(p int) int {
+	return uf.s[uf.Find(p)].size
+}
+
+// Len returns the number of elements.
+func (uf *Weighted[T, G]) Len() int {
+	return len(uf.s)
+}
@@ -0,0 +1,106 @@
+package unionfind
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestWeighted(t *testing.T) {
+	uf := NewWeighted(5, Sum[int]{})
+
+	// 1 is 3 heavier than 0, 2 is 5 heavier than 1, 2 is 4 lighter than 3
+	for _, r := range []struct{ p, q, diff int }{{0, 1, 3}, {1, 2, 5}, {3, 2, -4}} {
+		if err := uf.Union(r.p, r.q, r.diff); err != nil {
+			t.Fatalf("uf.Union(%d, %d, %d) returned an error: %v", r.p, r.q, r.diff, err)
+		}
+	}
+	for _, tt := range []struct{ p, q, want int }{{0, 2, 8}, {2, 0, -8}, {0, 3, 12}, {3, 1, -9}, {1, 1, 0}} {
+		if got, ok := uf.Diff(tt.p, tt.q); !ok || got != tt.want {
+			t.Errorf("uf.Diff(%d, %d) = (%d, %v), want %d", tt.p, tt.q, got, ok, tt.want)
+		}
+	}
+	if _, ok := uf.Diff(0, 4); ok {
+		t.Errorf("uf.Diff(0, 4) of disconnected elements reported them connected")
+	}
+	if c, s := uf.Count(), uf.Size(3); c != 2 || s != 4 {
+		t.Errorf("uf.Count() = %d, uf.Size(3) = %d; want 2, 4", c, s)
+	}
+
+	if err := uf.Union(3, 0, -12); err != nil {
+		t.Errorf("uf.Union() of a consistent relation returned an error: %v", err)
+	}
+	err := uf.Union(0, 3, 11)
+	var ce *ConflictError[int]
+	if !errors.As(err, &ce) || ce.Want != 12 || ce.Diff != 11 {
+		t.Errorf("uf.Union(0, 3, 11) error = %v, want *ConflictError with Want 12", err)
+	}
+}
+
+func TestWeightedWraparound(t *testing.T) {
+	// unsigned potentials form the group of integers modulo 256
+	uf := NewWeighted(3, Sum[uint8]{})
+	if err := uf.Union(0, 1, 250); err != nil {
+		t.Fatalf("uf.Union(0, 1, 250) returned an error: %v", err)
+	}
+	if err := uf.Union(1, 2, 10); err != nil {
+		t.Fatalf("uf.Union(1, 2, 10) returned an error: %v", err)
+	}
+	if d, ok := uf.Diff(0, 2); !ok || d != 4 {
+		t.Errorf("uf.Diff(0, 2) = (%d, %v), want 4", d, ok)
+	}
+	if d, ok := uf.Diff(2, 0); !ok || d != 252 {
+		t.Errorf("uf.Diff(2, 0) = (%d, %v), want 252", d, ok)
+	}
+	if err := uf.Union(0, 2, 4); err != nil {
+		t.Errorf("uf.Union(0, 2, 4) of a consistent relation returned an error: %v", err)
+	}
+}
+
+func TestWeightedParity(t *testing.T) {
+	// bipartiteness check: an edge means the endpoints have different colors
+	uf := NewWeighted(4, Xor{})
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 3}} {
+		if err := uf.Union(e[0], e[1], true); err != nil {
+			t.Fatalf("uf.Union(%d, %d) returned an error: %v", e[0], e[1], err)
+		}
+	}
+	if d, ok := uf.Diff(0, 2); !ok || d {
+		t.Errorf("uf.Diff(0, 2) = (%v, %v), want same parity", d, ok)
+	}
+	if err := uf.Union(3, 0, true); err != nil {
+		t.Errorf("closing an even cycle returned an error: %v", err)
+	}
+	if err := uf.Union(0, 2, true); err == nil {
+		t.Errorf("closing an odd cycle should return an error")
+	}
+}
+
+// Test the relations against explicit potentials of the elements under random unions.
+func TestWeightedRandomized(t *testing.T) {
+	const n = 200
+	rnd := rand.New(rand.NewSource(1))
+
+	pot := make([]int, n)
+	for i := range pot {
+		pot[i] = rnd.Intn(1000)
+	}
+	uf := NewWeighted(n, Sum[int]{})
+	ref := New(n)
+	for i := 0; i < 3*n; i++ {
+		p, q := rnd.Intn(n), rnd.Intn(n)
+		if err := uf.Union(p, q, pot[q]-pot[p]); err != nil {
+			t.Fatalf("uf.Union(%d, %d) returned an error: %v", p, q, err)
+		}
+		ref.Union(p, q)
+
+		p, q = rnd.Intn(n), rnd.Intn(n)
+		d, ok := uf.Diff(p, q)
+		if ok != ref.Connected(p, q) || ok && d != pot[q]-pot[p] {
+			t.Fatalf("uf.Diff(%d, %d) = (%d, %v), want (%d, %v)", p, q, d, ok, pot[q]-pot[p], ref.Connected(p, q))
+		}
+	}
+	if uf.Count() != ref.Count() {
+		t.Errorf("uf.Count() = %d, want %d", uf.Count(), ref.Count())
+	}
+}